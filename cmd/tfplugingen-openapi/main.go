@@ -38,7 +38,14 @@ func initCommands(ui cli.Ui) map[string]cli.CommandFactory {
 		}, nil
 	}
 
+	discoverFactory := func() (cli.Command, error) {
+		return &cmd.DiscoverCommand{
+			UI: ui,
+		}, nil
+	}
+
 	return map[string]cli.CommandFactory{
+		"discover": discoverFactory,
 		"generate": generateFactory,
 	}
 }
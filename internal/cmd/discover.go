@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/explorer"
+
+	"github.com/hashicorp/cli"
+	"github.com/pb33f/libopenapi"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/index"
+	"gopkg.in/yaml.v3"
+)
+
+type DiscoverCommand struct {
+	UI             cli.Ui
+	oasInputPath   string
+	flagOutputPath string
+}
+
+func (cmd *DiscoverCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("discover", flag.ExitOnError)
+	fs.StringVar(&cmd.flagOutputPath, "output", "./generator_config.yml", "destination file path for the discovered generator config (YAML)")
+	return fs
+}
+
+func (cmd *DiscoverCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-openapi discover [<args>] </path/to/oas_file.yml>\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (cmd *DiscoverCommand) Synopsis() string {
+	return "Discovers resources and data sources in an OpenAPI 3.x Specification and writes a starter generator config"
+}
+
+func (cmd *DiscoverCommand) Run(args []string) int {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		logger.Error("error parsing flags", "err", err)
+		return 1
+	}
+
+	cmd.oasInputPath = fs.Arg(0)
+	if cmd.oasInputPath == "" {
+		logger.Error("error executing command", "err", "OpenAPI specification file is required as last argument")
+		return 1
+	}
+
+	err = cmd.runInternal(logger)
+	if err != nil {
+		logger.Error("error executing command", "err", err)
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *DiscoverCommand) runInternal(logger *slog.Logger) error {
+	// 1. Read and parse OpenAPI spec file
+	oasBytes, err := os.ReadFile(cmd.oasInputPath)
+	if err != nil {
+		return fmt.Errorf("error reading OpenAPI spec file: %w", err)
+	}
+	doc, err := libopenapi.NewDocument(oasBytes)
+	if err != nil {
+		return fmt.Errorf("error parsing OpenAPI spec file: %w", err)
+	}
+
+	// 2. Build out the OpenAPI model, logging circular references as warnings and failing on any other model building errors
+	model, errs := doc.BuildV3Model()
+
+	var errResult error
+	for _, err := range errs {
+		if rslvErr, ok := err.(*index.ResolvingError); ok {
+			logger.Warn(
+				"circular reference found in OpenAPI spec",
+				"circular_ref", rslvErr.CircularReference.GenerateJourneyPath())
+			continue
+		}
+
+		errResult = errors.Join(errResult, err)
+	}
+	if errResult != nil {
+		return fmt.Errorf("error building OpenAPI 3.x model: %w", errResult)
+	}
+
+	// 3. Discover resources and data sources w/ naming conventions
+	configNode, err := discoverGeneratorConfig(explorer.NewGuesstimatorExplorer(model.Model), model.Model)
+	if err != nil {
+		return err
+	}
+
+	// 4. Use discovered config to create YAML
+	bytes, err := marshalYAML(configNode)
+	if err != nil {
+		return fmt.Errorf("error marshalling generator config to YAML: %w", err)
+	}
+
+	// 5. Output to file
+	output, err := os.Create(cmd.flagOutputPath)
+	if err != nil {
+		return fmt.Errorf("error creating output file for generator config: %w", err)
+	}
+
+	_, err = output.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing generator config to output: %w", err)
+	}
+
+	return nil
+}
+
+// discoverGeneratorConfig builds a generator config YAML document from the resources and data sources found by an explorer. A YAML node
+// is built directly, rather than marshalling a config.Config, so that ambiguous groupings can be marked with comments.
+func discoverGeneratorConfig(dora explorer.Explorer, spec high.Document) (*yaml.Node, error) {
+	explorerProvider, err := dora.FindProvider()
+	if err != nil {
+		return nil, fmt.Errorf("error finding provider: %w", err)
+	}
+
+	explorerResources, err := dora.FindResources()
+	if err != nil {
+		return nil, fmt.Errorf("error finding resources: %w", err)
+	}
+
+	explorerDataSources, err := dora.FindDataSources()
+	if err != nil {
+		return nil, fmt.Errorf("error finding data sources: %w", err)
+	}
+
+	endpoint := explorerProvider.Endpoint
+	if len(spec.Servers) > 0 && spec.Servers[0].URL != "" {
+		endpoint = spec.Servers[0].URL
+	}
+
+	providerNode := mappingNode()
+	appendScalar(providerNode, "name", explorerProvider.Name)
+	appendScalar(providerNode, "endpoint", endpoint)
+
+	root := mappingNode()
+	appendMapping(root, "provider", providerNode, "TODO: set the provider name and endpoint")
+
+	resourcesNode := mappingNode()
+	for _, name := range sortedKeys(explorerResources) {
+		resource := explorerResources[name]
+
+		resourceNode := mappingNode()
+		appendMapping(resourceNode, "create", locationNode(resource.CreateLocation), "")
+		appendMapping(resourceNode, "read", locationNode(resource.ReadLocation), "")
+		if len(resource.UpdateLocations) > 0 {
+			updateNode := &yaml.Node{Kind: yaml.SequenceNode}
+			for _, updateLocation := range resource.UpdateLocations {
+				updateNode.Content = append(updateNode.Content, locationNode(updateLocation))
+			}
+			appendMapping(resourceNode, "update", updateNode, "")
+		}
+		appendMapping(resourceNode, "delete", locationNode(resource.DeleteLocation), "")
+
+		appendMapping(resourcesNode, name, resourceNode, notesComment(resource.Notes))
+	}
+	if len(resourcesNode.Content) > 0 {
+		appendMapping(root, "resources", resourcesNode, "")
+	}
+
+	dataSourcesNode := mappingNode()
+	for _, name := range sortedKeys(explorerDataSources) {
+		dataSource := explorerDataSources[name]
+
+		dataSourceNode := mappingNode()
+		appendMapping(dataSourceNode, "read", locationNode(dataSource.ReadLocation), "")
+
+		appendMapping(dataSourcesNode, name, dataSourceNode, notesComment(dataSource.Notes))
+	}
+	if len(dataSourcesNode.Content) > 0 {
+		appendMapping(root, "datasources", dataSourcesNode, "")
+	}
+
+	return &yaml.Node{
+		Kind:        yaml.DocumentNode,
+		HeadComment: "Generated by `tfplugingen-openapi discover`, review all resources and data sources before use",
+		Content:     []*yaml.Node{root},
+	}, nil
+}
+
+func marshalYAML(node *yaml.Node) ([]byte, error) {
+	var strBuilder strings.Builder
+
+	encoder := yaml.NewEncoder(&strBuilder)
+	encoder.SetIndent(2)
+
+	err := encoder.Encode(node)
+	if err != nil {
+		return nil, err
+	}
+
+	err = encoder.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(strBuilder.String()), nil
+}
+
+func locationNode(location explorer.OperationLocation) *yaml.Node {
+	node := mappingNode()
+	appendScalar(node, "path", location.Path)
+	appendScalar(node, "method", location.Method)
+
+	return node
+}
+
+func notesComment(notes []string) string {
+	if len(notes) == 0 {
+		return ""
+	}
+
+	return "AMBIGUOUS: " + strings.Join(notes, "\nAMBIGUOUS: ")
+}
+
+func mappingNode() *yaml.Node {
+	return &yaml.Node{Kind: yaml.MappingNode}
+}
+
+func appendScalar(mapping *yaml.Node, key, value string) {
+	appendMapping(mapping, key, &yaml.Node{Kind: yaml.ScalarNode, Value: value}, "")
+}
+
+func appendMapping(mapping *yaml.Node, key string, value *yaml.Node, comment string) {
+	mapping.Content = append(mapping.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Value: key, HeadComment: comment},
+		value,
+	)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
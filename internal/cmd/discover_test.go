@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/cmd"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+)
+
+func TestDiscover(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasSpecPath         string
+		goldenFilePath      string
+		expectedResources   int
+		expectedDataSources int
+	}{
+		"Discover API": {
+			oasSpecPath:         "testdata/discover/openapi_spec.yml",
+			goldenFilePath:      "testdata/discover/generator_config.yml",
+			expectedResources:   1,
+			expectedDataSources: 3,
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tempConfigPath := path.Join(t.TempDir(), "generator_config.yml")
+
+			mockUi := cli.NewMockUi()
+			c := cmd.DiscoverCommand{UI: mockUi}
+			args := []string{
+				"--output", tempConfigPath,
+				testCase.oasSpecPath,
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running discover cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			goldenFileBytes, err := os.ReadFile(testCase.goldenFilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tempConfigBytes, err := os.ReadFile(tempConfigPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(string(tempConfigBytes), string(goldenFileBytes)); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			// The discovered config should always be a valid generator config
+			cfg, err := config.ParseConfig(tempConfigBytes)
+			if err != nil {
+				t.Fatalf("unexpected error parsing discovered config: %s", err)
+			}
+
+			if len(cfg.Resources) != testCase.expectedResources {
+				t.Errorf("expected %d resources, found %d resources", testCase.expectedResources, len(cfg.Resources))
+			}
+
+			if len(cfg.DataSources) != testCase.expectedDataSources {
+				t.Errorf("expected %d data sources, found %d data sources", testCase.expectedDataSources, len(cfg.DataSources))
+			}
+		})
+	}
+}
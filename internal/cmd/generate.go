@@ -5,23 +5,17 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"flag"
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 
-	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/config"
-	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/explorer"
-	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper"
-	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/sdk"
-	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/spec"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/pkg/generator"
 
 	"github.com/hashicorp/cli"
-	"github.com/pb33f/libopenapi"
-	"github.com/pb33f/libopenapi/index"
 )
 
 type GenerateCommand struct {
@@ -29,19 +23,23 @@ type GenerateCommand struct {
 	oasInputPath   string
 	flagConfigPath string
 	flagOutputPath string
-}
 
-type NcloudSpecification struct {
-	spec.Specification
-	Resources   []mapper.DetailResourceInfo   `json:"resources"`
-	DataSources []mapper.DetailDataSourceInfo `json:"datasources"`
-	Provider    *mapper.ProviderWithEndpoint  `json:"provider"`
+	flagSdkAll        bool
+	flagSdkOutputPath string
+	flagSdkPackage    string
+	flagSdkImportPath string
+	flagSdkGoMod      optionalBool
 }
 
 func (cmd *GenerateCommand) Flags() *flag.FlagSet {
 	fs := flag.NewFlagSet("generate", flag.ExitOnError)
 	fs.StringVar(&cmd.flagConfigPath, "config", "./generator_config.yml", "path to generator config file (YAML)")
 	fs.StringVar(&cmd.flagOutputPath, "output", "./provider_code_spec.json", "destination file path for generated provider code spec (JSON)")
+	fs.StringVar(&cmd.flagSdkOutputPath, "sdk-output", "", "destination directory for the generated Ncloud SDK layer, overrides 'sdk.output_dir' (default \"ncloudsdk\" next to the provider code spec)")
+	fs.StringVar(&cmd.flagSdkPackage, "sdk-package", "", "Go package name of the generated Ncloud SDK layer, overrides 'sdk.package' (default \"ncloudsdk\")")
+	fs.StringVar(&cmd.flagSdkImportPath, "sdk-import-path", "", "Go import path of the generated Ncloud SDK layer, overrides 'sdk.import_path'")
+	fs.Var(&cmd.flagSdkGoMod, "sdk-go-mod", "emit a standalone go.mod for the generated Ncloud SDK layer, with the import path as module path, overrides 'sdk.go_mod'")
+	fs.BoolVar(&cmd.flagSdkAll, "sdk-all", false, "generate the Ncloud SDK layer for every operation in the OpenAPI spec, instead of only the operations referenced by the generator config")
 	return fs
 }
 
@@ -115,131 +113,62 @@ func (cmd *GenerateCommand) Run(args []string) int {
 }
 
 func (cmd *GenerateCommand) runInternal(logger *slog.Logger) error {
-	// 1. Read and parse generator config file
+	// 1. Read generator config file
 	configBytes, err := os.ReadFile(cmd.flagConfigPath)
 	if err != nil {
 		return fmt.Errorf("error reading generator config file: %w", err)
 	}
-	config, err := config.ParseConfig(configBytes)
-	if err != nil {
-		return fmt.Errorf("error parsing generator config file: %w", err)
-	}
 
-	// 2. Read and parse OpenAPI spec file
+	// 2. Read OpenAPI spec file
 	oasBytes, err := os.ReadFile(cmd.oasInputPath)
 	if err != nil {
 		return fmt.Errorf("error reading OpenAPI spec file: %w", err)
 	}
-	doc, err := libopenapi.NewDocument(oasBytes)
-	if err != nil {
-		return fmt.Errorf("error parsing OpenAPI spec file: %w", err)
-	}
-
-	// 3. Build out the OpenAPI model, this will recursively load all local + remote references into one cohesive model
-	model, errs := doc.BuildV3Model()
-
-	// 3-1. Generate Ncloud SDK layer
-	if err = sdk.Generate(model); err != nil {
-		return fmt.Errorf("error generating Ncloud SDK layer: %w", err)
-	}
-
-	// 4. Log circular references as warnings and fail on any other model building errors
-	var errResult error
-	for _, err := range errs {
-		if rslvErr, ok := err.(*index.ResolvingError); ok {
-			logger.Warn(
-				"circular reference found in OpenAPI spec",
-				"circular_ref", rslvErr.CircularReference.GenerateJourneyPath())
-			continue
-		}
 
-		errResult = errors.Join(errResult, err)
-	}
-	if errResult != nil {
-		return fmt.Errorf("error building OpenAPI 3.x model: %w", errResult)
-	}
+	// 3. Generate Ncloud SDK layer and provider code spec, relative to the working directory
+	_, err = generator.Generate(context.TODO(), generator.Options{
+		Config:               configBytes,
+		ConfigDir:            filepath.Dir(cmd.flagConfigPath),
+		OpenAPISpec:          oasBytes,
+		ProviderCodeSpecPath: cmd.flagOutputPath,
+		SDK: generator.SDKOptions{
+			All:         cmd.flagSdkAll,
+			OutputDir:   cmd.flagSdkOutputPath,
+			PackageName: cmd.flagSdkPackage,
+			ImportPath:  cmd.flagSdkImportPath,
+			GoMod:       cmd.flagSdkGoMod.value,
+		},
+		Writer: generator.DirWriter{},
+		Logger: logger,
+	})
 
-	// 5. Generate provider code spec w/ config
-	oasExplorer := explorer.NewConfigExplorer(model.Model, *config)
-	providerCodeSpec, err := generateProviderCodeSpec(logger, oasExplorer, *config)
-	if err != nil {
-		return err
-	}
+	return err
+}
 
-	// 6. Use provider code spec to create JSON
-	bytes, err := json.MarshalIndent(providerCodeSpec, "", "\t")
-	if err != nil {
-		return fmt.Errorf("error marshalling provider code spec to JSON: %w", err)
-	}
+// optionalBool is a boolean flag that is nil unless it's set, so it only overrides the generator config when passed, i.e. `--sdk-go-mod=false`.
+type optionalBool struct {
+	value *bool
+}
 
-	// 7. Log a warning if the provider code spec is not valid based on the JSON schema
-	err = spec.Validate(context.TODO(), bytes)
-	if err != nil {
-		logger.Warn(
-			"generated provider code spec failed validation",
-			"validation_msg", err)
+func (b *optionalBool) String() string {
+	if b == nil || b.value == nil {
+		return ""
 	}
 
-	// 8. Output to file
-	output, err := os.Create(cmd.flagOutputPath)
-	if err != nil {
-		return fmt.Errorf("error creating output file for provider code spec: %w", err)
-	}
+	return strconv.FormatBool(*b.value)
+}
 
-	_, err = output.Write(bytes)
+func (b *optionalBool) Set(s string) error {
+	value, err := strconv.ParseBool(s)
 	if err != nil {
-		return fmt.Errorf("error writing provider code spec to output: %w", err)
+		return err
 	}
 
+	b.value = &value
 	return nil
 }
 
-func generateProviderCodeSpec(logger *slog.Logger, dora explorer.Explorer, cfg config.Config) (*NcloudSpecification, error) {
-	// 1. Find TF resources in OAS
-	explorerResources, err := dora.FindResources()
-	if err != nil {
-		return nil, fmt.Errorf("error finding resource(s): %w", err)
-	}
-
-	// 2. Find TF data sources in OAS
-	explorerDataSources, err := dora.FindDataSources()
-	if err != nil {
-		return nil, fmt.Errorf("error finding data source(s): %w", err)
-	}
-
-	// 3. Find TF provider in OAS
-	explorerProvider, err := dora.FindProvider()
-	if err != nil {
-		return nil, fmt.Errorf("error finding provider: %w", err)
-	}
-
-	// 4. Use TF info to generate provider code spec for resources
-	resourceMapper := mapper.NewResourceMapper(explorerResources, cfg)
-	resourcesIR, err := resourceMapper.MapToIR(logger)
-	if err != nil {
-		return nil, fmt.Errorf("error generating provider code spec for resources: %w", err)
-	}
-
-	// 5. Use TF info to generate provider code spec for data sources
-	dataSourceMapper := mapper.NewDataSourceMapper(explorerDataSources, cfg)
-	dataSourcesIR, err := dataSourceMapper.MapToIR(logger)
-	if err != nil {
-		return nil, fmt.Errorf("error generating provider code spec for data sources: %w", err)
-	}
-
-	// 6. Use TF info to generate provider code spec for provider
-	providerMapper := mapper.NewProviderMapper(explorerProvider, cfg)
-	providerIR, err := providerMapper.MapToIR(logger)
-	if err != nil {
-		return nil, fmt.Errorf("error generating provider code spec for provider: %w", err)
-	}
-
-	return &NcloudSpecification{
-		Specification: spec.Specification{
-			Version: spec.Version0_1,
-		},
-		Provider:    providerIR,
-		Resources:   resourcesIR,
-		DataSources: dataSourcesIR,
-	}, nil
+// IsBoolFlag allows the flag to be passed without a value, i.e. `--sdk-go-mod`
+func (b *optionalBool) IsBoolFlag() bool {
+	return true
 }
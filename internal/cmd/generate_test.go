@@ -83,3 +83,45 @@ func TestGenerate_WithConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerate_SdkGoModFlag(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		args     []string
+		expected string
+	}{
+		// Unset, the flag doesn't override the generator config
+		"unset": {
+			args:     []string{},
+			expected: "",
+		},
+		"without value": {
+			args:     []string{"--sdk-go-mod"},
+			expected: "true",
+		},
+		"false": {
+			args:     []string{"--sdk-go-mod=false"},
+			expected: "false",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			generateCmd := &cmd.GenerateCommand{}
+			fs := generateCmd.Flags()
+
+			if err := fs.Parse(testCase.args); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := fs.Lookup("sdk-go-mod").Value.String(); got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -6,7 +6,10 @@ package config
 import (
 	"errors"
 	"fmt"
+	"go/token"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -19,11 +22,25 @@ import (
 //   - .category = NO MATCH
 var attributeLocationRegex = regexp.MustCompile(`^[\w]+(?:\.[\w]+)*$`)
 
+// This regex matches ignore locations, which are attribute locations that also support wildcards in each segment and an
+// optional array items suffix
+//   - category.* = MATCH
+//   - **.regionCode = MATCH
+//   - server_list[*].internal_* = MATCH
+//   - category..id = NO MATCH
+//   - category[0].id = NO MATCH
+var ignoreLocationRegex = regexp.MustCompile(`^[\w*]+(?:\[\*\])?(?:\.[\w*]+(?:\[\*\])?)*$`)
+
 // Config represents a YAML generator config.
 type Config struct {
 	Provider    Provider              `yaml:"provider"`
 	Resources   map[string]Resource   `yaml:"resources"`
 	DataSources map[string]DataSource `yaml:"datasources"`
+	Defaults    Defaults              `yaml:"defaults"`
+	SDK         SDK                   `yaml:"sdk"`
+
+	// node is the parsed YAML document, used for line numbers in validation errors
+	node *yaml.Node
 }
 
 // Provider generator config section.
@@ -58,6 +75,27 @@ type DataSource struct {
 	SchemaOptions       SchemaOptions        `yaml:"schema"`
 }
 
+// Defaults generator config section. This section contains options that apply to every provider, resource, and data source schema.
+type Defaults struct {
+	// IntegerType is the attribute type for `integer` schemas with a missing or unsupported `format`, one of `int32`, `int64`, or `number`.
+	// Defaults to `int64`.
+	IntegerType string `yaml:"integer_type"`
+}
+
+// SDK generator config section. This section contains options for the generated Ncloud SDK layer, which can be overridden by CLI flags.
+type SDK struct {
+	// OutputDir is the directory the SDK is generated in. Relative paths are relative to the generator config file.
+	// Defaults to `ncloudsdk`, next to the provider code spec.
+	OutputDir string `yaml:"output_dir"`
+	// Package is the Go package name of the SDK. Defaults to `ncloudsdk`.
+	Package string `yaml:"package"`
+	// ImportPath is the Go import path of the SDK, i.e. `github.com/example/terraform-provider-example/internal/ncloudsdk`.
+	// Required for `go_mod`, as the module path.
+	ImportPath string `yaml:"import_path"`
+	// GoMod emits a standalone go.mod in the output directory, so the SDK is its own module.
+	GoMod bool `yaml:"go_mod"`
+}
+
 // OpenApiSpecLocation defines a location in an OpenAPI spec for an API operation.
 type OpenApiSpecLocation struct {
 	// Matches the path key for a path item (refer to [OAS Paths Object]).
@@ -68,6 +106,17 @@ type OpenApiSpecLocation struct {
 	//
 	// [OAS Path Item Object]: https://spec.openapis.org/oas/v3.1.0#pathItemObject
 	Method string `yaml:"method"`
+	// Matches the unique operationId of an operation (refer to [OAS Operation Object]). This is an alternative to `path` and
+	// `method`, for specs where paths change between API versions but operationIds are stable.
+	//
+	// [OAS Operation Object]: https://spec.openapis.org/oas/v3.1.0#operation-object
+	OperationId string `yaml:"operation_id"`
+	// ResponsePath is a JSON pointer to a subschema of the response body (refer to [JSON Pointer]), which will be used for mapping instead
+	// of the entire response body. Only supported for create and read operations.
+	//   - /getServerInstanceListResponse/serverInstanceList/0
+	//
+	// [JSON Pointer]: https://datatracker.ietf.org/doc/html/rfc6901
+	ResponsePath string `yaml:"response_path"`
 }
 
 // SchemaOptions generator config section. This section contains options for modifying the output of the generator.
@@ -75,6 +124,13 @@ type SchemaOptions struct {
 	// Ignores are a slice of strings, representing an attribute location to ignore during mapping (dot-separated for nested attributes).
 	Ignores          []string         `yaml:"ignores"`
 	AttributeOptions AttributeOptions `yaml:"attributes"`
+	// CreateFromParameters will map the `query` and `path` parameters of a resource create operation as the main schema, instead
+	// of the create operation request body. This is intended for RPC-style APIs that carry every input as a query parameter.
+	CreateFromParameters bool `yaml:"create_from_parameters"`
+	// UseStateForUnknown controls the UseStateForUnknown plan modifier of a resource's computed attributes. By default, attributes that are
+	// only in the create or read response bodies will use the prior state, instead of showing as unknown on every plan, which can be
+	// disabled with `false`.
+	UseStateForUnknown *bool `yaml:"use_state_for_unknown"`
 }
 
 // AttributeOptions generator config section. This section is used to modify the output of specific attributes.
@@ -91,15 +147,45 @@ type Override struct {
 	Description string `yaml:"description"`
 	// ComputedOptionalRequired overrides the inferred value from the OpenAPI specification.
 	ComputedOptionalRequired string `yaml:"computed_optional_required"`
+	// Type overrides the mapped collection type of a list or set attribute, either `list` or `set`.
+	Type string `yaml:"type"`
+	// Sensitive overrides the inferred value from the OpenAPI specification (`format: password`).
+	Sensitive *bool `yaml:"sensitive"`
+	// Default sets a static default value, which must match the type of a bool, number, or string resource attribute.
+	Default any `yaml:"default"`
+	// DeprecationMessage overrides the deprecation message that was mapped from the OpenAPI specification.
+	DeprecationMessage string `yaml:"deprecation_message"`
+	// Validators are appended to the validators that were mapped from the OpenAPI specification.
+	Validators []Validator `yaml:"validators"`
+	// RequiresReplace adds or removes the RequiresReplace plan modifier of a resource attribute. By default, attributes in the create
+	// request body that aren't in any update request body will require replacement, which can be disabled with `false`.
+	RequiresReplace *bool `yaml:"requires_replace"`
+	// UseStateForUnknown adds or removes the UseStateForUnknown plan modifier of a resource attribute.
+	UseStateForUnknown *bool `yaml:"use_state_for_unknown"`
+}
+
+// Validator generator config section. This section defines a custom validator to add to an attribute.
+type Validator struct {
+	// SchemaDefinition is the validator as Go code in the schema, i.e. `stringvalidator.LengthAtLeast(1)`.
+	SchemaDefinition string `yaml:"schema_definition"`
+	// Imports are the Go package paths required by the schema definition.
+	Imports []string `yaml:"imports"`
 }
 
 // ParseConfig takes in a byte array (of YAML), unmarshals into a Config struct, and validates the result
 func ParseConfig(bytes []byte) (*Config, error) {
+	var node yaml.Node
+	err := yaml.Unmarshal(bytes, &node)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling config: %w", err)
+	}
+
 	var result Config
-	err := yaml.Unmarshal(bytes, &result)
+	err = node.Decode(&result)
 	if err != nil {
 		return nil, fmt.Errorf("error unmarshaling config: %w", err)
 	}
+	result.node = &node
 
 	if err = result.Validate(); err != nil {
 		return nil, fmt.Errorf("config validation error(s):\n%w", err)
@@ -108,6 +194,47 @@ func ParseConfig(bytes []byte) (*Config, error) {
 	return &result, nil
 }
 
+// Line returns the line number of a location in the generator config YAML, where each key is either a mapping key or a sequence
+// index. If the full location can't be found, the line number of the closest parent is returned. Returns 0 if the config was not
+// created with ParseConfig.
+//   - Line("resources", "thing", "update", "0", "path")
+func (c Config) Line(keys ...string) int {
+	if c.node == nil || len(c.node.Content) == 0 {
+		return 0
+	}
+
+	current := c.node.Content[0]
+	line := current.Line
+
+	for _, key := range keys {
+		var next *yaml.Node
+
+		switch current.Kind {
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(current.Content); i += 2 {
+				if current.Content[i].Value == key {
+					line = current.Content[i].Line
+					next = current.Content[i+1]
+					break
+				}
+			}
+		case yaml.SequenceNode:
+			index, err := strconv.Atoi(key)
+			if err == nil && index >= 0 && index < len(current.Content) {
+				next = current.Content[index]
+				line = next.Line
+			}
+		}
+
+		if next == nil {
+			break
+		}
+		current = next
+	}
+
+	return line
+}
+
 func (c Config) Validate() error {
 	var result error
 
@@ -121,6 +248,18 @@ func (c Config) Validate() error {
 		result = errors.Join(result, fmt.Errorf("\tprovider %w", err))
 	}
 
+	// Validate Defaults
+	err = c.Defaults.Validate()
+	if err != nil {
+		result = errors.Join(result, fmt.Errorf("\tdefaults %w", err))
+	}
+
+	// Validate SDK
+	err = c.SDK.Validate()
+	if err != nil {
+		result = errors.Join(result, fmt.Errorf("\tsdk %w", err))
+	}
+
 	// Validate all Resources
 	for name, resource := range c.Resources {
 		err := resource.Validate()
@@ -152,7 +291,7 @@ func (p Provider) Validate() error {
 	}
 
 	for _, ignore := range p.Ignores {
-		if !attributeLocationRegex.MatchString(ignore) {
+		if !ignoreLocationRegex.MatchString(ignore) {
 			result = errors.Join(result, fmt.Errorf("invalid item for ignores: %q - must be dot-separated string", ignore))
 		}
 	}
@@ -160,6 +299,32 @@ func (p Provider) Validate() error {
 	return result
 }
 
+func (d Defaults) Validate() error {
+	var result error
+
+	switch d.IntegerType {
+	case "", "int32", "int64", "number":
+	default:
+		result = errors.Join(result, fmt.Errorf("invalid 'integer_type': %q - must be 'int32', 'int64', or 'number'", d.IntegerType))
+	}
+
+	return result
+}
+
+func (s SDK) Validate() error {
+	var result error
+
+	if s.Package != "" && !token.IsIdentifier(s.Package) {
+		result = errors.Join(result, fmt.Errorf("invalid 'package': %q - must be a valid Go package name", s.Package))
+	}
+
+	if s.GoMod && s.ImportPath == "" {
+		result = errors.Join(result, errors.New("must have an 'import_path' property when 'go_mod' is enabled"))
+	}
+
+	return result
+}
+
 func (r Resource) Validate() error {
 	var result error
 
@@ -189,6 +354,9 @@ func (r Resource) Validate() error {
 				if err != nil {
 					result = errors.Join(result, fmt.Errorf("invalid update[%d]: %w", i, err))
 				}
+				if update != nil && update.ResponsePath != "" {
+					result = errors.Join(result, fmt.Errorf("invalid update[%d]: 'response_path' is only supported for create and read", i))
+				}
 			}
 		}
 	}
@@ -197,6 +365,9 @@ func (r Resource) Validate() error {
 	if err != nil {
 		result = errors.Join(result, fmt.Errorf("invalid delete: %w", err))
 	}
+	if r.Delete != nil && r.Delete.ResponsePath != "" {
+		result = errors.Join(result, errors.New("invalid delete: 'response_path' is only supported for create and read"))
+	}
 
 	err = r.SchemaOptions.Validate()
 	if err != nil {
@@ -232,12 +403,22 @@ func (o *OpenApiSpecLocation) Validate() error {
 		return nil
 	}
 
-	if o.Path == "" {
-		result = errors.Join(result, errors.New("'path' property is required"))
+	if o.OperationId != "" {
+		if o.Path != "" || o.Method != "" {
+			result = errors.Join(result, errors.New("'operation_id' property cannot be used with 'path' or 'method'"))
+		}
+	} else {
+		if o.Path == "" {
+			result = errors.Join(result, errors.New("'path' property is required"))
+		}
+
+		if o.Method == "" {
+			result = errors.Join(result, errors.New("'method' property is required"))
+		}
 	}
 
-	if o.Method == "" {
-		result = errors.Join(result, errors.New("'method' property is required"))
+	if o.ResponsePath != "" && !strings.HasPrefix(o.ResponsePath, "/") {
+		result = errors.Join(result, fmt.Errorf("invalid 'response_path': %q - must be a JSON pointer starting with '/'", o.ResponsePath))
 	}
 
 	return result
@@ -252,7 +433,7 @@ func (s *SchemaOptions) Validate() error {
 	}
 
 	for _, ignore := range s.Ignores {
-		if !attributeLocationRegex.MatchString(ignore) {
+		if !ignoreLocationRegex.MatchString(ignore) {
 			result = errors.Join(result, fmt.Errorf("invalid item for ignores: %q - must be dot-separated string", ignore))
 		}
 	}
@@ -263,10 +444,39 @@ func (s *SchemaOptions) Validate() error {
 func (s *AttributeOptions) Validate() error {
 	var result error
 
-	for path := range s.Overrides {
+	for path, override := range s.Overrides {
 		if !attributeLocationRegex.MatchString(path) {
 			result = errors.Join(result, fmt.Errorf("invalid key for override: %q - must be dot-separated string", path))
 		}
+
+		err := override.Validate()
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("invalid override for %q: %w", path, err))
+		}
+	}
+
+	return result
+}
+
+func (o *Override) Validate() error {
+	var result error
+
+	switch o.Type {
+	case "", "list", "set":
+	default:
+		result = errors.Join(result, fmt.Errorf("invalid 'type': %q - must be 'list' or 'set'", o.Type))
+	}
+
+	switch o.Default.(type) {
+	case nil, bool, int, int64, float64, string:
+	default:
+		result = errors.Join(result, fmt.Errorf("invalid 'default': %v - must be a bool, number, or string", o.Default))
+	}
+
+	for i, validator := range o.Validators {
+		if validator.SchemaDefinition == "" {
+			result = errors.Join(result, fmt.Errorf("invalid validators[%d]: must have a 'schema_definition' property", i))
+		}
 	}
 
 	return result
@@ -21,6 +21,45 @@ func TestParseConfig_Valid(t *testing.T) {
 provider:
   name: example
 
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+		},
+		"valid defaults": {
+			input: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+defaults:
+  integer_type: int32
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+		},
+		"valid sdk": {
+			input: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+sdk:
+  output_dir: ./internal/ncloudsdk
+  package: ncloudsdk
+  import_path: github.com/example/terraform-provider-example/internal/ncloudsdk
+  go_mod: true
+
 resources:
   thing:
     create:
@@ -87,6 +126,94 @@ resources:
     schema:
       ignores:
         - valid.ignore.combo`,
+		},
+		"valid resource with response paths": {
+			input: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+      response_path: /createThingResponse/thingList/0
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+      response_path: /getThingResponse/thingList/0`,
+		},
+		"valid resource with operation ids": {
+			input: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+resources:
+  thing:
+    create:
+      operation_id: createThing
+    read:
+      operation_id: getThing
+    update:
+      - operation_id: updateThing
+    delete:
+      operation_id: deleteThing`,
+		},
+		"valid resource with wildcard ignores": {
+			input: `
+provider:
+  name: example
+  endpoint: https://example.com
+  ignores:
+    - "**.regionCode"
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      ignores:
+        - "*.createDate"
+        - server_list[*].internal_*`,
+		},
+		"valid resource with extended overrides": {
+			input: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      use_state_for_unknown: true
+      attributes:
+        overrides:
+          token:
+            sensitive: true
+            deprecation_message: Use 'api_key' instead
+            validators:
+              - schema_definition: stringvalidator.LengthAtLeast(1)
+                imports:
+                  - github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator
+          port:
+            default: 8080
+          tags:
+            type: set
+            requires_replace: false
+          created_at:
+            use_state_for_unknown: false`,
 		},
 		"valid single data source": {
 			input: `
@@ -233,6 +360,54 @@ provider:
   name: example`,
 			expectedErrRegex: `at least one object is required in either 'resources' or 'data_sources'`,
 		},
+		"defaults - invalid integer type": {
+			input: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+defaults:
+  integer_type: float
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+			expectedErrRegex: `defaults invalid 'integer_type': \"float\" - must be 'int32', 'int64', or 'number'`,
+		},
+		"sdk - invalid package": {
+			input: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+sdk:
+  package: ncloud-sdk
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+			expectedErrRegex: `sdk invalid 'package': \"ncloud-sdk\" - must be a valid Go package name`,
+		},
+		"sdk - go_mod requires import_path": {
+			input: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+sdk:
+  go_mod: true
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+			expectedErrRegex: `sdk must have an 'import_path' property when 'go_mod' is enabled`,
+		},
 		"resource - create required": {
 			input: `
 provider:
@@ -279,6 +454,30 @@ resources:
       path: /example/path/to/things`,
 			expectedErrRegex: `invalid create: 'method' property is required`,
 		},
+		"resource - invalid create - operation_id with path": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      operation_id: createThing
+      path: /example/path/to/things`,
+			expectedErrRegex: `invalid create: 'operation_id' property cannot be used with 'path' or 'method'`,
+		},
+		"resource - invalid read - operation_id with method": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    read:
+      operation_id: getThing
+      method: GET`,
+			expectedErrRegex: `invalid read: 'operation_id' property cannot be used with 'path' or 'method'`,
+		},
 		"resource - invalid read - path required": {
 			input: `
 provider:
@@ -343,6 +542,72 @@ resources:
             description: Here is a test description for the 'hey' property`,
 			expectedErrRegex: `invalid key for override: \".hey\"`,
 		},
+		"resource - invalid override type": {
+			input: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          tags:
+            type: map`,
+			expectedErrRegex: `invalid override for \"tags\": invalid 'type': \"map\"`,
+		},
+		"resource - invalid override default": {
+			input: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          tags:
+            default:
+              - one`,
+			expectedErrRegex: `invalid override for \"tags\": invalid 'default'`,
+		},
+		"resource - invalid override validator": {
+			input: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          name:
+            validators:
+              - imports:
+                  - github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator`,
+			expectedErrRegex: `invalid override for \"name\": invalid validators\[0\]: must have a 'schema_definition' property`,
+		},
 		"resource - invalid ignore item": {
 			input: `
 provider:
@@ -361,6 +626,60 @@ resources:
         - .invalid.ignore.`,
 			expectedErrRegex: `invalid item for ignores: \".invalid.ignore.\"`,
 		},
+		"resource - invalid wildcard ignore item": {
+			input: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      ignores:
+        - category[0].id`,
+			expectedErrRegex: `invalid item for ignores: \"category\[0\].id\"`,
+		},
+		"resource - invalid response path": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+      response_path: createThingResponse/thingList/0
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+			expectedErrRegex: `invalid create: invalid 'response_path': \"createThingResponse/thingList/0\" - must be a JSON pointer starting with '/'`,
+		},
+		"resource - response path not supported for delete": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    delete:
+      path: /example/path/to/thing/{id}
+      method: DELETE
+      response_path: /deleteThingResponse`,
+			expectedErrRegex: `invalid delete: 'response_path' is only supported for create and read`,
+		},
 		"data source - read required": {
 			input: `
 provider:
@@ -442,3 +761,71 @@ data_sources:
 		})
 	}
 }
+
+func TestConfig_Line(t *testing.T) {
+	t.Parallel()
+
+	input := `provider:
+  name: example
+  endpoint: https://example.com
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    update:
+      - path: /example/path/to/thing/{id}
+        method: PUT
+    schema:
+      ignores:
+        - first
+        - second`
+
+	testCases := map[string]struct {
+		keys     []string
+		expected int
+	}{
+		"root": {
+			keys:     nil,
+			expected: 1,
+		},
+		"mapping key": {
+			keys:     []string{"resources", "thing", "read", "method"},
+			expected: 12,
+		},
+		"sequence index": {
+			keys:     []string{"resources", "thing", "update", "0", "method"},
+			expected: 15,
+		},
+		"sequence scalar": {
+			keys:     []string{"resources", "thing", "schema", "ignores", "1"},
+			expected: 19,
+		},
+		"not found - closest parent": {
+			keys:     []string{"resources", "thing", "delete", "path"},
+			expected: 6,
+		},
+	}
+
+	cfg, err := config.ParseConfig([]byte(input))
+	if err != nil {
+		t.Fatalf("unexpected error parsing config: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := cfg.Line(testCase.keys...)
+			if got != testCase.expected {
+				t.Errorf("expected line %d, got %d", testCase.expected, got)
+			}
+		})
+	}
+}
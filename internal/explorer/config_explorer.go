@@ -16,6 +16,7 @@ import (
 	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
 	lowbase "github.com/pb33f/libopenapi/datamodel/low/base"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 )
 
 var _ Explorer = configExplorer{}
@@ -60,44 +61,67 @@ func (e configExplorer) FindResources() (map[string]Resource, error) {
 	resources := map[string]Resource{}
 	var errResult error
 	for name, resourceConfig := range e.config.Resources {
-		createOp, err := extractOp(e.spec.Paths, resourceConfig.Create)
+		createOp, createLocation, err := extractOp(e.spec.Paths, resourceConfig.Create)
 		if err != nil {
 			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.create': %w", name, err))
 			continue
 		}
-		readOp, err := extractOp(e.spec.Paths, resourceConfig.Read)
+		readOp, readLocation, err := extractOp(e.spec.Paths, resourceConfig.Read)
 		if err != nil {
 			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.read': %w", name, err))
 			continue
 		}
 		var updateOps []*high.Operation
+		var updateLocations []OperationLocation
 		for _, updateLoc := range resourceConfig.Update {
-			updateOp, err := extractOp(e.spec.Paths, updateLoc)
+			updateOp, updateLocation, err := extractOp(e.spec.Paths, updateLoc)
 			if err != nil {
 				errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.update': %w", name, err))
 				continue
 			}
 			updateOps = append(updateOps, updateOp)
+			updateLocations = append(updateLocations, updateLocation)
 		}
-		deleteOp, err := extractOp(e.spec.Paths, resourceConfig.Delete)
+		deleteOp, deleteLocation, err := extractOp(e.spec.Paths, resourceConfig.Delete)
 		if err != nil {
 			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.delete': %w", name, err))
 			continue
 		}
 
-		commonParameters, err := extractCommonParameters(e.spec.Paths, resourceConfig.Read.Path)
-		if err != nil {
-			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s' common parameters: %w", name, err))
-			continue
+		var commonParameters []*high.Parameter
+		if resourceConfig.Read != nil {
+			commonParameters, err = extractCommonParameters(e.spec.Paths, readLocation.Path)
+			if err != nil {
+				errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s' common parameters: %w", name, err))
+				continue
+			}
+		}
+
+		var createCommonParameters []*high.Parameter
+		if resourceConfig.Create != nil {
+			createCommonParameters, err = extractCommonParameters(e.spec.Paths, createLocation.Path)
+			if err != nil {
+				errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.create' common parameters: %w", name, err))
+				continue
+			}
 		}
 
 		resources[name] = Resource{
-			CreateOp:         createOp,
-			ReadOp:           readOp,
-			UpdateOps:        updateOps,
-			DeleteOp:         deleteOp,
-			CommonParameters: commonParameters,
-			SchemaOptions:    extractSchemaOptions(resourceConfig.SchemaOptions),
+			CreateOp:               createOp,
+			ReadOp:                 readOp,
+			UpdateOps:              updateOps,
+			DeleteOp:               deleteOp,
+			CommonParameters:       commonParameters,
+			CreateCommonParameters: createCommonParameters,
+			SchemaOptions:          extractSchemaOptions(resourceConfig.SchemaOptions),
+
+			CreateLocation:  createLocation,
+			ReadLocation:    readLocation,
+			UpdateLocations: updateLocations,
+			DeleteLocation:  deleteLocation,
+
+			CreateResponsePath: extractResponsePath(resourceConfig.Create),
+			ReadResponsePath:   extractResponsePath(resourceConfig.Read),
 		}
 	}
 
@@ -109,59 +133,133 @@ func (e configExplorer) FindDataSources() (map[string]DataSource, error) {
 	var errResult error
 
 	for name, dataSourceConfig := range e.config.DataSources {
-		readOp, err := extractOp(e.spec.Paths, dataSourceConfig.Read)
+		readOp, readLocation, err := extractOp(e.spec.Paths, dataSourceConfig.Read)
 		if err != nil {
 			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.read': %w", name, err))
 			continue
 		}
 
-		commonParameters, err := extractCommonParameters(e.spec.Paths, dataSourceConfig.Read.Path)
-		if err != nil {
-			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s' common parameters: %w", name, err))
-			continue
+		var commonParameters []*high.Parameter
+		if dataSourceConfig.Read != nil {
+			commonParameters, err = extractCommonParameters(e.spec.Paths, readLocation.Path)
+			if err != nil {
+				errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s' common parameters: %w", name, err))
+				continue
+			}
 		}
 
 		dataSources[name] = DataSource{
 			ReadOp:           readOp,
 			CommonParameters: commonParameters,
 			SchemaOptions:    extractSchemaOptions(dataSourceConfig.SchemaOptions),
+
+			ReadLocation: readLocation,
+
+			ReadResponsePath: extractResponsePath(dataSourceConfig.Read),
 		}
 	}
 	return dataSources, errResult
 }
 
-func extractOp(paths *high.Paths, oasLocation *config.OpenApiSpecLocation) (*high.Operation, error) {
+// FindOperation will find the operation for a generator config location in an OpenAPI spec, either by `operation_id` or by `path`
+// and `method`. An error is returned if the location can't be resolved to an operation.
+func FindOperation(spec high.Document, oasLocation *config.OpenApiSpecLocation) (*high.Operation, OperationLocation, error) {
+	op, location, err := extractOp(spec.Paths, oasLocation)
+	if err != nil {
+		return nil, OperationLocation{}, err
+	}
+
+	if op == nil && oasLocation != nil {
+		return nil, OperationLocation{}, fmt.Errorf("method '%s' not found at OpenAPI path '%s'", oasLocation.Method, oasLocation.Path)
+	}
+
+	return op, location, nil
+}
+
+// extractOp will find the operation for a generator config location, either by `operation_id` or by `path` and `method`. The returned
+// OperationLocation is the path and method the operation was found at.
+func extractOp(paths *high.Paths, oasLocation *config.OpenApiSpecLocation) (*high.Operation, OperationLocation, error) {
 	// No need to search OAS if not defined
 	if oasLocation == nil {
-		return nil, nil
+		return nil, OperationLocation{}, nil
+	}
+
+	if oasLocation.OperationId != "" {
+		return extractOpById(paths, oasLocation.OperationId)
 	}
 
 	if paths == nil || paths.PathItems == nil || paths.PathItems.GetOrZero(oasLocation.Path) == nil {
-		return nil, fmt.Errorf("path '%s' not found in OpenAPI spec", oasLocation.Path)
+		return nil, OperationLocation{}, fmt.Errorf("path '%s' not found in OpenAPI spec", oasLocation.Path)
 	}
 
 	pathItem, _ := paths.PathItems.Get(oasLocation.Path)
+	location := OperationLocation{
+		Path:   oasLocation.Path,
+		Method: oasLocation.Method,
+	}
 
 	switch strings.ToLower(oasLocation.Method) {
 	case low.PostLabel:
-		return pathItem.Post, nil
+		return pathItem.Post, location, nil
 	case low.GetLabel:
-		return pathItem.Get, nil
+		return pathItem.Get, location, nil
 	case low.PutLabel:
-		return pathItem.Put, nil
+		return pathItem.Put, location, nil
 	case low.DeleteLabel:
-		return pathItem.Delete, nil
+		return pathItem.Delete, location, nil
 	case low.PatchLabel:
-		return pathItem.Patch, nil
+		return pathItem.Patch, location, nil
 	case low.OptionsLabel:
-		return pathItem.Options, nil
+		return pathItem.Options, location, nil
 	case low.HeadLabel:
-		return pathItem.Head, nil
+		return pathItem.Head, location, nil
 	case low.TraceLabel:
-		return pathItem.Trace, nil
+		return pathItem.Trace, location, nil
 	default:
-		return nil, fmt.Errorf("method '%s' not found at OpenAPI path '%s'", oasLocation.Method, oasLocation.Path)
+		return nil, OperationLocation{}, fmt.Errorf("method '%s' not found at OpenAPI path '%s'", oasLocation.Method, oasLocation.Path)
+	}
+}
+
+// extractOpById will search every path item in the OAS for an operation with a matching `operationId`. An error is returned if no
+// operation, or more than one operation, is found.
+func extractOpById(paths *high.Paths, operationId string) (*high.Operation, OperationLocation, error) {
+	var foundOp *high.Operation
+	var foundLocation OperationLocation
+
+	if paths != nil && paths.PathItems != nil {
+		for pathPair := range orderedmap.Iterate(context.TODO(), paths.PathItems) {
+			for opPair := range orderedmap.Iterate(context.TODO(), pathPair.Value().GetOperations()) {
+				if opPair.Value().OperationId != operationId {
+					continue
+				}
+
+				if foundOp != nil {
+					return nil, OperationLocation{}, fmt.Errorf("operation_id '%s' is not unique, found at '%s %s' and '%s %s'",
+						operationId, foundLocation.Method, foundLocation.Path, strings.ToUpper(opPair.Key()), pathPair.Key())
+				}
+
+				foundOp = opPair.Value()
+				foundLocation = OperationLocation{
+					Path:   pathPair.Key(),
+					Method: strings.ToUpper(opPair.Key()),
+				}
+			}
+		}
+	}
+
+	if foundOp == nil {
+		return nil, OperationLocation{}, fmt.Errorf("operation_id '%s' not found in OpenAPI spec", operationId)
+	}
+
+	return foundOp, foundLocation, nil
+}
+
+func extractResponsePath(oasLocation *config.OpenApiSpecLocation) string {
+	if oasLocation == nil {
+		return ""
 	}
+
+	return oasLocation.ResponsePath
 }
 
 func extractCommonParameters(paths *high.Paths, path string) ([]*high.Parameter, error) {
@@ -209,6 +307,8 @@ func extractSchemaOptions(cfgSchemaOpts config.SchemaOptions) SchemaOptions {
 			Aliases:   cfgSchemaOpts.AttributeOptions.Aliases,
 			Overrides: extractOverrides(cfgSchemaOpts.AttributeOptions.Overrides),
 		},
+		CreateFromParameters: cfgSchemaOpts.CreateFromParameters,
+		UseStateForUnknown:   cfgSchemaOpts.UseStateForUnknown,
 	}
 }
 
@@ -218,8 +318,31 @@ func extractOverrides(cfgOverrides map[string]config.Override) map[string]Overri
 		overrides[key] = Override{
 			Description:              cfgOverride.Description,
 			ComputedOptionalRequired: cfgOverride.ComputedOptionalRequired,
+			Type:                     cfgOverride.Type,
+			Sensitive:                cfgOverride.Sensitive,
+			Default:                  cfgOverride.Default,
+			DeprecationMessage:       cfgOverride.DeprecationMessage,
+			Validators:               extractValidators(cfgOverride.Validators),
+			RequiresReplace:          cfgOverride.RequiresReplace,
+			UseStateForUnknown:       cfgOverride.UseStateForUnknown,
 		}
 	}
 
 	return overrides
 }
+
+func extractValidators(cfgValidators []config.Validator) []Validator {
+	if len(cfgValidators) == 0 {
+		return nil
+	}
+
+	validators := make([]Validator, len(cfgValidators))
+	for i, cfgValidator := range cfgValidators {
+		validators[i] = Validator{
+			SchemaDefinition: cfgValidator.SchemaDefinition,
+			Imports:          cfgValidator.Imports,
+		}
+	}
+
+	return validators
+}
@@ -96,6 +96,69 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 						Description: "delete op here",
 						OperationId: "delete_resource",
 					},
+					CreateLocation: explorer.OperationLocation{Path: "/resources", Method: "POST"},
+					ReadLocation:   explorer.OperationLocation{Path: "/resources/{resource_id}", Method: "GET"},
+					UpdateLocations: []explorer.OperationLocation{
+						{Path: "/resources/{resource_id}", Method: "PUT"},
+					},
+					DeleteLocation: explorer.OperationLocation{Path: "/resources/{resource_id}", Method: "DELETE"},
+					SchemaOptions: explorer.SchemaOptions{
+						AttributeOptions: explorer.AttributeOptions{
+							Overrides: map[string]explorer.Override{},
+						},
+					},
+				},
+			},
+		},
+		"common parameters of create and read path items": {
+			config: config.Config{
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						Create: &config.OpenApiSpecLocation{
+							Path:   "/createResource",
+							Method: "GET",
+						},
+						Read: &config.OpenApiSpecLocation{
+							Path:   "/getResourceDetail",
+							Method: "GET",
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/createResource": {
+					Parameters: []*high.Parameter{
+						{Name: "regionCode", In: "query"},
+					},
+					Get: &high.Operation{
+						OperationId: "create_resource",
+					},
+				},
+				"/getResourceDetail": {
+					Parameters: []*high.Parameter{
+						{Name: "resourceNo", In: "query"},
+					},
+					Get: &high.Operation{
+						OperationId: "read_resource",
+					},
+				},
+			}),
+			want: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: &high.Operation{
+						OperationId: "create_resource",
+					},
+					ReadOp: &high.Operation{
+						OperationId: "read_resource",
+					},
+					CommonParameters: []*high.Parameter{
+						{Name: "resourceNo", In: "query"},
+					},
+					CreateCommonParameters: []*high.Parameter{
+						{Name: "regionCode", In: "query"},
+					},
+					CreateLocation: explorer.OperationLocation{Path: "/createResource", Method: "GET"},
+					ReadLocation:   explorer.OperationLocation{Path: "/getResourceDetail", Method: "GET"},
 					SchemaOptions: explorer.SchemaOptions{
 						AttributeOptions: explorer.AttributeOptions{
 							Overrides: map[string]explorer.Override{},
@@ -173,6 +236,12 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 						Description: "delete op here",
 						OperationId: "delete_resource",
 					},
+					CreateLocation: explorer.OperationLocation{Path: "/resources/one", Method: "OPTIONS"},
+					ReadLocation:   explorer.OperationLocation{Path: "/resources/two/{resource_id}", Method: "HEAD"},
+					UpdateLocations: []explorer.OperationLocation{
+						{Path: "/resources/three/{resource_id}", Method: "PATCH"},
+					},
+					DeleteLocation: explorer.OperationLocation{Path: "/resources/one", Method: "TRACE"},
 					SchemaOptions: explorer.SchemaOptions{
 						AttributeOptions: explorer.AttributeOptions{
 							Overrides: map[string]explorer.Override{},
@@ -181,6 +250,127 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 				},
 			},
 		},
+		"valid CRUD ops - operation_id": {
+			config: config.Config{
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						Create: &config.OpenApiSpecLocation{
+							OperationId: "create_resource",
+						},
+						Read: &config.OpenApiSpecLocation{
+							OperationId: "read_resource",
+						},
+						Update: []*config.OpenApiSpecLocation{
+							{
+								OperationId: "update_resource",
+							},
+						},
+						Delete: &config.OpenApiSpecLocation{
+							OperationId: "delete_resource",
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+				},
+				"/resources/{resource_id}": {
+					Get: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+					Patch: &high.Operation{
+						Description: "update op here",
+						OperationId: "update_resource",
+					},
+					Delete: &high.Operation{
+						Description: "delete op here",
+						OperationId: "delete_resource",
+					},
+				},
+			}),
+			want: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+					ReadOp: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+					UpdateOps: []*high.Operation{
+						{
+							Description: "update op here",
+							OperationId: "update_resource",
+						},
+					},
+					DeleteOp: &high.Operation{
+						Description: "delete op here",
+						OperationId: "delete_resource",
+					},
+					CreateLocation: explorer.OperationLocation{Path: "/resources", Method: "POST"},
+					ReadLocation:   explorer.OperationLocation{Path: "/resources/{resource_id}", Method: "GET"},
+					UpdateLocations: []explorer.OperationLocation{
+						{Path: "/resources/{resource_id}", Method: "PATCH"},
+					},
+					DeleteLocation: explorer.OperationLocation{Path: "/resources/{resource_id}", Method: "DELETE"},
+					SchemaOptions: explorer.SchemaOptions{
+						AttributeOptions: explorer.AttributeOptions{
+							Overrides: map[string]explorer.Override{},
+						},
+					},
+				},
+			},
+		},
+		"non-existent operation_id throws error": {
+			config: config.Config{
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						Create: &config.OpenApiSpecLocation{
+							OperationId: "fake_operation",
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+				},
+			}),
+			expectedErr: errors.New(`failed to extract 'test_resource.create': operation_id 'fake_operation' not found in OpenAPI spec`),
+		},
+		"duplicate operation_id throws error": {
+			config: config.Config{
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						Create: &config.OpenApiSpecLocation{
+							OperationId: "create_resource",
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+					Put: &high.Operation{
+						Description: "other create op here",
+						OperationId: "create_resource",
+					},
+				},
+			}),
+			expectedErr: errors.New(`failed to extract 'test_resource.create': operation_id 'create_resource' is not unique, found at 'PUT /resources' and 'POST /resources'`),
+		},
 		"non-existent create path throws error": {
 			config: config.Config{
 				Resources: map[string]config.Resource{
@@ -315,6 +505,8 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 						Description: "read op here",
 						OperationId: "read_resource",
 					},
+					CreateLocation: explorer.OperationLocation{Path: "/resources", Method: "POST"},
+					ReadLocation:   explorer.OperationLocation{Path: "/resources/{resource_id}", Method: "GET"},
 					SchemaOptions: explorer.SchemaOptions{
 						Ignores: []string{"ignore1.abc", "ignore2.def"},
 						AttributeOptions: explorer.AttributeOptions{
@@ -355,7 +547,7 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(got, testCase.want, cmpopts.IgnoreUnexported(high.Operation{})); testCase.expectedErr == nil && diff != "" {
+			if diff := cmp.Diff(got, testCase.want, cmpopts.IgnoreUnexported(high.Operation{}, high.Parameter{})); testCase.expectedErr == nil && diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
 		})
@@ -396,6 +588,7 @@ func Test_ConfigExplorer_FindDataSources(t *testing.T) {
 						Description: "read op here",
 						OperationId: "read_resource",
 					},
+					ReadLocation: explorer.OperationLocation{Path: "/resources/{resource_id}", Method: "GET"},
 					SchemaOptions: explorer.SchemaOptions{
 						AttributeOptions: explorer.AttributeOptions{
 							Overrides: map[string]explorer.Override{},
@@ -429,6 +622,40 @@ func Test_ConfigExplorer_FindDataSources(t *testing.T) {
 						Description: "read op here",
 						OperationId: "read_resource",
 					},
+					ReadLocation: explorer.OperationLocation{Path: "/resources/two/{resource_id}", Method: "HEAD"},
+					SchemaOptions: explorer.SchemaOptions{
+						AttributeOptions: explorer.AttributeOptions{
+							Overrides: map[string]explorer.Override{},
+						},
+					},
+				},
+			},
+		},
+		"valid read op - operation_id": {
+			config: config.Config{
+				DataSources: map[string]config.DataSource{
+					"test_resource": {
+						Read: &config.OpenApiSpecLocation{
+							OperationId: "read_resource",
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources/{resource_id}": {
+					Get: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+				},
+			}),
+			want: map[string]explorer.DataSource{
+				"test_resource": {
+					ReadOp: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+					ReadLocation: explorer.OperationLocation{Path: "/resources/{resource_id}", Method: "GET"},
 					SchemaOptions: explorer.SchemaOptions{
 						AttributeOptions: explorer.AttributeOptions{
 							Overrides: map[string]explorer.Override{},
@@ -510,6 +737,7 @@ func Test_ConfigExplorer_FindDataSources(t *testing.T) {
 						Description: "read op here",
 						OperationId: "read_resource",
 					},
+					ReadLocation: explorer.OperationLocation{Path: "/resources/{resource_id}", Method: "GET"},
 					SchemaOptions: explorer.SchemaOptions{
 						Ignores: []string{"ignore1.abc", "ignore2.def"},
 						AttributeOptions: explorer.AttributeOptions{
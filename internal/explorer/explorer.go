@@ -23,6 +23,23 @@ type Resource struct {
 	DeleteOp         *high.Operation
 	CommonParameters []*high.Parameter
 	SchemaOptions    SchemaOptions
+
+	// CreateCommonParameters are the parameters of the path item of the Create operation, as CommonParameters are the parameters
+	// of the path item of the Read operation.
+	CreateCommonParameters []*high.Parameter
+
+	// CreateLocation, ReadLocation, UpdateLocations and DeleteLocation are the paths and methods of the CRUD operations.
+	CreateLocation  OperationLocation
+	ReadLocation    OperationLocation
+	UpdateLocations []OperationLocation
+	DeleteLocation  OperationLocation
+
+	// CreateResponsePath and ReadResponsePath are JSON pointers to the subschema of the response body to map, if populated.
+	CreateResponsePath string
+	ReadResponsePath   string
+
+	// Notes are messages about how the operations were found, such as ambiguous groupings of API paths.
+	Notes []string
 }
 
 // DataSource contains a Read operation and schema options for configuration.
@@ -30,6 +47,21 @@ type DataSource struct {
 	ReadOp           *high.Operation
 	CommonParameters []*high.Parameter
 	SchemaOptions    SchemaOptions
+
+	// ReadLocation is the path and method of the Read operation.
+	ReadLocation OperationLocation
+
+	// ReadResponsePath is a JSON pointer to the subschema of the response body to map, if populated.
+	ReadResponsePath string
+
+	// Notes are messages about how the operation was found, such as ambiguous groupings of API paths.
+	Notes []string
+}
+
+// OperationLocation contains the path and method that an operation was found at in the OpenAPI spec.
+type OperationLocation struct {
+	Path   string
+	Method string
 }
 
 // Provider contains a name and a schema.
@@ -41,8 +73,10 @@ type Provider struct {
 }
 
 type SchemaOptions struct {
-	Ignores          []string
-	AttributeOptions AttributeOptions
+	Ignores              []string
+	AttributeOptions     AttributeOptions
+	CreateFromParameters bool
+	UseStateForUnknown   *bool
 }
 
 type AttributeOptions struct {
@@ -53,4 +87,16 @@ type AttributeOptions struct {
 type Override struct {
 	Description              string
 	ComputedOptionalRequired string
+	Type                     string
+	Sensitive                *bool
+	Default                  any
+	DeprecationMessage       string
+	Validators               []Validator
+	RequiresReplace          *bool
+	UseStateForUnknown       *bool
+}
+
+type Validator struct {
+	SchemaDefinition string
+	Imports          []string
 }
@@ -26,6 +26,10 @@ func mergeParameters(commonParameters []*high.Parameter, operation *high.Operati
 	return mergedParameters
 }
 
+func (e *Resource) CreateOpParameters() []*high.Parameter {
+	return mergeParameters(e.CreateCommonParameters, e.CreateOp)
+}
+
 func (e *Resource) ReadOpParameters() []*high.Parameter {
 	return mergeParameters(e.CommonParameters, e.ReadOp)
 }
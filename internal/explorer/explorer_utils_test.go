@@ -671,6 +671,64 @@ func TestReadOpParameters_DataSource(t *testing.T) {
 	}
 }
 
+func TestCreateOpParameters(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		createOp     *high.Operation
+		commonParams []*high.Parameter
+		want         []string
+	}{
+		"operation only": {
+			createOp: &high.Operation{
+				Parameters: []*high.Parameter{
+					{Name: "serverName", In: "query"},
+				},
+			},
+			want: []string{"query:serverName"},
+		},
+		"merge common and operation": {
+			createOp: &high.Operation{
+				Parameters: []*high.Parameter{
+					{Name: "serverName", In: "query"},
+					{Name: "regionCode", In: "query"},
+				},
+			},
+			commonParams: []*high.Parameter{
+				{Name: "regionCode", In: "path"},
+				{Name: "responseFormatType", In: "query"},
+			},
+			want: []string{"query:regionCode", "query:responseFormatType", "query:serverName"},
+		},
+		"common only": {
+			commonParams: []*high.Parameter{
+				{Name: "responseFormatType", In: "query"},
+			},
+			want: []string{"query:responseFormatType"},
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource := Resource{
+				CreateOp:               testCase.createOp,
+				CreateCommonParameters: testCase.commonParams,
+			}
+
+			got := []string{}
+			for _, param := range resource.CreateOpParameters() {
+				got = append(got, param.In+":"+param.Name)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference for resource: %s", diff)
+			}
+		})
+	}
+}
+
 func pointer[T any](value T) *T {
 	return &value
 }
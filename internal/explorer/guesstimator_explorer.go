@@ -17,7 +17,7 @@ import (
 var _ Explorer = guesstimatorExplorer{}
 
 // guesstimatorExplorer is an experimental explorer that reads an OpenAPI specification without any configuration and attempts to
-// discover resources and data sources based on a naming convention. It's not currently in-use in the OpenAPI spec generator.
+// discover resources and data sources based on a naming convention. It's used by the `discover` command to scaffold a generator config.
 type guesstimatorExplorer struct {
 	spec high.Document
 }
@@ -32,6 +32,21 @@ type resourceOperations struct {
 
 	// CollectionOps are operations (GET, PUT, POST, DELETE, etc.) on a path that don't end with a parameter: /path
 	CollectionOps map[string]*high.Operation
+
+	// IdentityPaths and CollectionPaths are the API paths of the IdentityOps and CollectionOps, keyed by the same method
+	IdentityPaths   map[string]string
+	CollectionPaths map[string]string
+
+	// Notes are messages about ambiguous groupings, i.e. multiple API paths with the same resource name
+	Notes []string
+}
+
+func (r resourceOperations) identityLocation(method string) OperationLocation {
+	return OperationLocation{Path: r.IdentityPaths[method], Method: strings.ToUpper(method)}
+}
+
+func (r resourceOperations) collectionLocation(method string) OperationLocation {
+	return OperationLocation{Path: r.CollectionPaths[method], Method: strings.ToUpper(method)}
 }
 
 // As the name suggests, the Guesstimator evaluates an OpenAPIv3 spec and will return
@@ -87,13 +102,23 @@ func (e guesstimatorExplorer) FindResources() (map[string]Resource, error) {
 
 		// Fallback to POST on identity
 		createOp := group.CollectionOps["post"]
+		createLocation := group.collectionLocation("post")
 		if createOp == nil {
 			createOp = group.IdentityOps["post"]
+			createLocation = group.identityLocation("post")
 		}
 
+		notes := group.Notes
+
 		var updateOps []*high.Operation
+		var updateLocations []OperationLocation
 		if group.IdentityOps["put"] != nil {
 			updateOps = append(updateOps, group.IdentityOps["put"])
+			updateLocations = append(updateLocations, group.identityLocation("put"))
+
+			if group.IdentityOps["patch"] != nil {
+				notes = append(notes, fmt.Sprintf("both PUT and PATCH found at '%s', using PUT for update", group.IdentityPaths["put"]))
+			}
 		}
 
 		resourcesMap[name] = Resource{
@@ -101,6 +126,13 @@ func (e guesstimatorExplorer) FindResources() (map[string]Resource, error) {
 			ReadOp:    group.IdentityOps["get"],
 			UpdateOps: updateOps,
 			DeleteOp:  group.IdentityOps["delete"],
+
+			CreateLocation:  createLocation,
+			ReadLocation:    group.identityLocation("get"),
+			UpdateLocations: updateLocations,
+			DeleteLocation:  group.identityLocation("delete"),
+
+			Notes: notes,
 		}
 	}
 
@@ -121,11 +153,19 @@ func (e guesstimatorExplorer) FindDataSources() (map[string]DataSource, error) {
 
 		if group.IdentityOps["get"] != nil {
 			// Combine all schemas into something that can be translated to framework IR
-			dataSourcesMap[name+"_by_id"] = DataSource{ReadOp: group.IdentityOps["get"]}
+			dataSourcesMap[name+"_by_id"] = DataSource{
+				ReadOp:       group.IdentityOps["get"],
+				ReadLocation: group.identityLocation("get"),
+				Notes:        group.Notes,
+			}
 		}
 
 		if group.CollectionOps["get"] != nil {
-			dataSourcesMap[name+"_collection"] = DataSource{ReadOp: group.CollectionOps["get"]}
+			dataSourcesMap[name+"_collection"] = DataSource{
+				ReadOp:       group.CollectionOps["get"],
+				ReadLocation: group.collectionLocation("get"),
+				Notes:        group.Notes,
+			}
 		}
 	}
 
@@ -140,22 +180,33 @@ func (e guesstimatorExplorer) groupPathItems() map[string]resourceOperations {
 	for pair := range orderedmap.Iterate(context.TODO(), e.spec.Paths.PathItems) {
 		resource, isIdentity := convertPathToResourceName(pair.Key())
 
-		_, ok := groups[resource]
+		group, ok := groups[resource]
 		if !ok {
-			groups[resource] = resourceOperations{
-				IdentityOps:   map[string]*high.Operation{},
-				CollectionOps: map[string]*high.Operation{},
+			group = resourceOperations{
+				IdentityOps:     map[string]*high.Operation{},
+				CollectionOps:   map[string]*high.Operation{},
+				IdentityPaths:   map[string]string{},
+				CollectionPaths: map[string]string{},
 			}
 		}
 
-		ops := pair.Value().GetOperations()
-		for opPair := range orderedmap.Iterate(context.TODO(), ops) {
-			if isIdentity {
-				groups[resource].IdentityOps[opPair.Key()] = opPair.Value()
-			} else {
-				groups[resource].CollectionOps[opPair.Key()] = opPair.Value()
+		ops, paths := group.CollectionOps, group.CollectionPaths
+		if isIdentity {
+			ops, paths = group.IdentityOps, group.IdentityPaths
+		}
+
+		for opPair := range orderedmap.Iterate(context.TODO(), pair.Value().GetOperations()) {
+			method := opPair.Key()
+			if existingPath, ok := paths[method]; ok && existingPath != pair.Key() {
+				group.Notes = append(group.Notes, fmt.Sprintf("both '%s' and '%s' are grouped as '%s', using %s '%s'",
+					existingPath, pair.Key(), resource, strings.ToUpper(method), pair.Key()))
 			}
+
+			ops[method] = opPair.Value()
+			paths[method] = pair.Key()
 		}
+
+		groups[resource] = group
 	}
 
 	return groups
@@ -207,9 +207,10 @@ func TestDataSourceAttributes_ApplyOverrides(t *testing.T) {
 		overrides          map[string]explorer.Override
 		attributes         attrmapper.DataSourceAttributes
 		expectedAttributes attrmapper.DataSourceAttributes
+		expectErr          bool
 	}{
-		// TODO: this may eventually return an error, but for now just returns without modification
 		"no matching overrides": {
+			expectErr: true,
 			overrides: map[string]explorer.Override{
 				"": {
 					Description: "new description",
@@ -353,13 +354,40 @@ func TestDataSourceAttributes_ApplyOverrides(t *testing.T) {
 				},
 			},
 		},
+		"invalid default override": {
+			expectErr: true,
+			overrides: map[string]explorer.Override{
+				"string_attribute": {
+					Default: "default value",
+				},
+			},
+			attributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceStringAttribute{
+					Name: "string_attribute",
+					StringAttribute: datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceStringAttribute{
+					Name: "string_attribute",
+					StringAttribute: datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		name, testCase := name, testCase
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, _ := testCase.attributes.ApplyOverrides(testCase.overrides)
+			got, err := testCase.attributes.ApplyOverrides(testCase.overrides)
+			if (err != nil) != testCase.expectErr {
+				t.Errorf("expected error: %t, got: %v", testCase.expectErr, err)
+			}
 
 			if diff := cmp.Diff(got, testCase.expectedAttributes); diff != "" {
 				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
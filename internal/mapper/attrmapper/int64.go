@@ -4,11 +4,15 @@
 package attrmapper
 
 import (
+	"errors"
+
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/provider"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceInt64Attribute struct {
@@ -32,13 +36,36 @@ func (a *ResourceInt64Attribute) Merge(mergeAttribute ResourceAttribute) (Resour
 }
 
 func (a *ResourceInt64Attribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	err := overrideType(override, "int64")
+	if err != nil {
+		return nil, err
+	}
 
-	cor, err := ApplyComputedOptionalRequiredOverride(override.ComputedOptionalRequired)
+	a.Description = overrideString(a.Description, override.Description)
+	a.DeprecationMessage = overrideString(a.DeprecationMessage, override.DeprecationMessage)
+	a.Sensitive = overrideBool(a.Sensitive, override.Sensitive)
+	for _, validator := range overrideValidators(override.Validators) {
+		a.Validators = append(a.Validators, schema.Int64Validator{Custom: validator})
+	}
+	a.PlanModifiers = overrideRequiresReplace(a.PlanModifiers, override.RequiresReplace, frameworkplanmodifiers.Int64PlanModifierPackage)
+	a.PlanModifiers = overrideUseStateForUnknown(a.PlanModifiers, override.UseStateForUnknown, frameworkplanmodifiers.Int64PlanModifierPackage)
+
+	a.ComputedOptionalRequired, err = overrideComputedOptionalRequired(a.ComputedOptionalRequired, override.ComputedOptionalRequired)
 	if err != nil {
 		return nil, err
 	}
-	a.ComputedOptionalRequired = cor
+
+	if override.Default != nil {
+		a.Default, err = overrideInt64Default(override.Default)
+		if err != nil {
+			return nil, err
+		}
+
+		a.ComputedOptionalRequired, err = overrideDefaultComputedOptionalRequired(a.ComputedOptionalRequired, override)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return a, nil
 }
@@ -71,7 +98,17 @@ func (a *DataSourceInt64Attribute) Merge(mergeAttribute DataSourceAttribute) (Da
 }
 
 func (a *DataSourceInt64Attribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	err := errors.Join(overrideType(override, "int64"), overrideDataSource(override))
+	if err != nil {
+		return nil, err
+	}
+
+	a.Description = overrideString(a.Description, override.Description)
+	a.DeprecationMessage = overrideString(a.DeprecationMessage, override.DeprecationMessage)
+	a.Sensitive = overrideBool(a.Sensitive, override.Sensitive)
+	for _, validator := range overrideValidators(override.Validators) {
+		a.Validators = append(a.Validators, schema.Int64Validator{Custom: validator})
+	}
 
 	return a, nil
 }
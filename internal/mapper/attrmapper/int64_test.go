@@ -153,6 +153,26 @@ func TestResourceInt64Attribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override default": {
+			attribute: attrmapper.ResourceInt64Attribute{
+				Name: "test_attribute",
+				Int64Attribute: resource.Int64Attribute{
+					ComputedOptionalRequired: schema.Optional,
+				},
+			},
+			override: explorer.Override{
+				Default: 10,
+			},
+			expectedAttribute: &attrmapper.ResourceInt64Attribute{
+				Name: "test_attribute",
+				Int64Attribute: resource.Int64Attribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					Default: &schema.Int64Default{
+						Static: pointer(int64(10)),
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		name, testCase := name, testCase
@@ -4,11 +4,15 @@
 package attrmapper
 
 import (
+	"errors"
+
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/provider"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceListAttribute struct {
@@ -37,13 +41,41 @@ func (a *ResourceListAttribute) Merge(mergeAttribute ResourceAttribute) (Resourc
 }
 
 func (a *ResourceListAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	err := errors.Join(overrideCollectionType(override), overrideDefault(override, "list"))
+	if err != nil {
+		return nil, err
+	}
 
-	cor, err := ApplyComputedOptionalRequiredOverride(override.ComputedOptionalRequired)
+	a.Description = overrideString(a.Description, override.Description)
+	a.DeprecationMessage = overrideString(a.DeprecationMessage, override.DeprecationMessage)
+	a.Sensitive = overrideBool(a.Sensitive, override.Sensitive)
+	for _, validator := range overrideValidators(override.Validators) {
+		a.Validators = append(a.Validators, schema.ListValidator{Custom: validator})
+	}
+	a.PlanModifiers = overrideRequiresReplace(a.PlanModifiers, override.RequiresReplace, frameworkplanmodifiers.ListPlanModifierPackage)
+	a.PlanModifiers = overrideUseStateForUnknown(a.PlanModifiers, override.UseStateForUnknown, frameworkplanmodifiers.ListPlanModifierPackage)
+
+	a.ComputedOptionalRequired, err = overrideComputedOptionalRequired(a.ComputedOptionalRequired, override.ComputedOptionalRequired)
 	if err != nil {
 		return nil, err
 	}
-	a.ComputedOptionalRequired = cor
+
+	if override.Type == overrideTypeSet {
+		return &ResourceSetAttribute{
+			SetAttribute: resource.SetAttribute{
+				AssociatedExternalType:   a.AssociatedExternalType,
+				ComputedOptionalRequired: a.ComputedOptionalRequired,
+				CustomType:               a.CustomType,
+				DeprecationMessage:       a.DeprecationMessage,
+				Description:              a.Description,
+				ElementType:              a.ElementType,
+				PlanModifiers:            convertPlanModifiers[schema.ListPlanModifier, schema.SetPlanModifier](a.PlanModifiers, frameworkplanmodifiers.ListPlanModifierPackage, frameworkplanmodifiers.SetPlanModifierPackage),
+				Sensitive:                a.Sensitive,
+				Validators:               listToSetValidators(a.Validators),
+			},
+			Name: a.Name,
+		}, nil
+	}
 
 	return a, nil
 }
@@ -81,7 +113,33 @@ func (a *DataSourceListAttribute) Merge(mergeAttribute DataSourceAttribute) (Dat
 }
 
 func (a *DataSourceListAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	err := errors.Join(overrideCollectionType(override), overrideDataSource(override))
+	if err != nil {
+		return nil, err
+	}
+
+	a.Description = overrideString(a.Description, override.Description)
+	a.DeprecationMessage = overrideString(a.DeprecationMessage, override.DeprecationMessage)
+	a.Sensitive = overrideBool(a.Sensitive, override.Sensitive)
+	for _, validator := range overrideValidators(override.Validators) {
+		a.Validators = append(a.Validators, schema.ListValidator{Custom: validator})
+	}
+
+	if override.Type == overrideTypeSet {
+		return &DataSourceSetAttribute{
+			SetAttribute: datasource.SetAttribute{
+				AssociatedExternalType:   a.AssociatedExternalType,
+				ComputedOptionalRequired: a.ComputedOptionalRequired,
+				CustomType:               a.CustomType,
+				DeprecationMessage:       a.DeprecationMessage,
+				Description:              a.Description,
+				ElementType:              a.ElementType,
+				Sensitive:                a.Sensitive,
+				Validators:               listToSetValidators(a.Validators),
+			},
+			Name: a.Name,
+		}, nil
+	}
 
 	return a, nil
 }
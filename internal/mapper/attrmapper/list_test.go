@@ -8,6 +8,8 @@ import (
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
@@ -513,6 +515,77 @@ func TestResourceListAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override type to set": {
+			attribute: attrmapper.ResourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: resource.ListAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("description"),
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Validators: schema.ListValidators{
+						{
+							Custom: frameworkvalidators.ListValidatorSizeAtLeast(1),
+						},
+						{
+							Custom: frameworkvalidators.ListValidatorUniqueValues(),
+						},
+					},
+				},
+			},
+			override: explorer.Override{
+				Type: "set",
+			},
+			expectedAttribute: &attrmapper.ResourceSetAttribute{
+				Name: "test_attribute",
+				SetAttribute: resource.SetAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("description"),
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Validators: schema.SetValidators{
+						{
+							Custom: frameworkvalidators.SetValidatorSizeAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+		"override type to set with requires replace": {
+			attribute: attrmapper.ResourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: resource.ListAttribute{
+					ComputedOptionalRequired: schema.Required,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					PlanModifiers: schema.ListPlanModifiers{
+						{
+							Custom: frameworkplanmodifiers.RequiresReplace(frameworkplanmodifiers.ListPlanModifierPackage),
+						},
+					},
+				},
+			},
+			override: explorer.Override{
+				Type: "set",
+			},
+			expectedAttribute: &attrmapper.ResourceSetAttribute{
+				Name: "test_attribute",
+				SetAttribute: resource.SetAttribute{
+					ComputedOptionalRequired: schema.Required,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					PlanModifiers: schema.SetPlanModifiers{
+						{
+							Custom: frameworkplanmodifiers.RequiresReplace(frameworkplanmodifiers.SetPlanModifierPackage),
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		name, testCase := name, testCase
@@ -1027,6 +1100,29 @@ func TestDataSourceListAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override type to set": {
+			attribute: attrmapper.DataSourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: datasource.ListAttribute{
+					ComputedOptionalRequired: schema.Computed,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+				},
+			},
+			override: explorer.Override{
+				Type: "set",
+			},
+			expectedAttribute: &attrmapper.DataSourceSetAttribute{
+				Name: "test_attribute",
+				SetAttribute: datasource.SetAttribute{
+					ComputedOptionalRequired: schema.Computed,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		name, testCase := name, testCase
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrmapper
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	overrideTypeList = "list"
+	overrideTypeSet  = "set"
+)
+
+// overrideString returns the override value, or the current value if there is no override.
+func overrideString(current *string, value string) *string {
+	if value == "" {
+		return current
+	}
+
+	return &value
+}
+
+// overrideBool returns the override value, or the current value if there is no override.
+func overrideBool(current *bool, value *bool) *bool {
+	if value == nil {
+		return current
+	}
+
+	return value
+}
+
+// overrideComputedOptionalRequired returns the override value, or the current value if there is no override.
+func overrideComputedOptionalRequired(current schema.ComputedOptionalRequired, value string) (schema.ComputedOptionalRequired, error) {
+	cor, err := ApplyComputedOptionalRequiredOverride(value)
+	if err != nil {
+		return "", err
+	}
+
+	if cor == "" {
+		return current, nil
+	}
+
+	return cor, nil
+}
+
+// overrideDefaultComputedOptionalRequired returns the ComputedOptionalRequired of an attribute with a default override. Terraform
+// requires an attribute with a default to be computed, so required and optional attributes are changed to computed_optional.
+func overrideDefaultComputedOptionalRequired(current schema.ComputedOptionalRequired, override explorer.Override) (schema.ComputedOptionalRequired, error) {
+	switch override.ComputedOptionalRequired {
+	case string(schema.Required), string(schema.Optional):
+		return "", fmt.Errorf("default override cannot be used with computed_optional_required: %s", override.ComputedOptionalRequired)
+	}
+
+	if current == schema.Computed {
+		return current, nil
+	}
+
+	return schema.ComputedOptional, nil
+}
+
+// overrideType returns an error if a type override is set for an attribute that can't change types.
+func overrideType(override explorer.Override, attributeType string) error {
+	if override.Type == "" {
+		return nil
+	}
+
+	return fmt.Errorf("type override %q is not supported for %s attributes", override.Type, attributeType)
+}
+
+// overrideDefault returns an error if a default override is set for an attribute that doesn't support static defaults.
+func overrideDefault(override explorer.Override, attributeType string) error {
+	if override.Default == nil {
+		return nil
+	}
+
+	return fmt.Errorf("default override is not supported for %s attributes", attributeType)
+}
+
+// overrideCollectionType returns an error if a type override is set to anything other than a collection type.
+func overrideCollectionType(override explorer.Override) error {
+	switch override.Type {
+	case "", overrideTypeList, overrideTypeSet:
+		return nil
+	default:
+		return fmt.Errorf("type override %q is not supported, must be %q or %q", override.Type, overrideTypeList, overrideTypeSet)
+	}
+}
+
+// overrideDataSource returns an error if an override is set that is only supported for resource attributes.
+func overrideDataSource(override explorer.Override) error {
+	var err error
+
+	if override.Default != nil {
+		err = errors.Join(err, errors.New("default override is not supported for data source attributes"))
+	}
+
+	if override.RequiresReplace != nil {
+		err = errors.Join(err, errors.New("requires_replace override is not supported for data source attributes"))
+	}
+
+	if override.UseStateForUnknown != nil {
+		err = errors.Join(err, errors.New("use_state_for_unknown override is not supported for data source attributes"))
+	}
+
+	return err
+}
+
+func overrideBoolDefault(value any) (*schema.BoolDefault, error) {
+	boolValue, ok := value.(bool)
+	if !ok {
+		return nil, invalidDefaultError(value, "bool")
+	}
+
+	return &schema.BoolDefault{Static: &boolValue}, nil
+}
+
+func overrideFloat64Default(value any) (*schema.Float64Default, error) {
+	var float64Value float64
+
+	switch v := value.(type) {
+	case float64:
+		float64Value = v
+	case int:
+		float64Value = float64(v)
+	case int64:
+		float64Value = float64(v)
+	default:
+		return nil, invalidDefaultError(value, "float64")
+	}
+
+	return &schema.Float64Default{Static: &float64Value}, nil
+}
+
+func overrideInt32Default(value any) (*schema.Int32Default, error) {
+	int64Value, err := int64DefaultValue(value, "int32")
+	if err != nil {
+		return nil, err
+	}
+
+	if int64Value < math.MinInt32 || int64Value > math.MaxInt32 {
+		return nil, invalidDefaultError(value, "int32")
+	}
+
+	int32Value := int32(int64Value)
+
+	return &schema.Int32Default{Static: &int32Value}, nil
+}
+
+func overrideInt64Default(value any) (*schema.Int64Default, error) {
+	int64Value, err := int64DefaultValue(value, "int64")
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.Int64Default{Static: &int64Value}, nil
+}
+
+func int64DefaultValue(value any, attributeType string) (int64, error) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	default:
+		return 0, invalidDefaultError(value, attributeType)
+	}
+}
+
+func overrideStringDefault(value any) (*schema.StringDefault, error) {
+	stringValue, ok := value.(string)
+	if !ok {
+		return nil, invalidDefaultError(value, "string")
+	}
+
+	return &schema.StringDefault{Static: &stringValue}, nil
+}
+
+func invalidDefaultError(value any, attributeType string) error {
+	return fmt.Errorf("invalid default override for %s attribute: %#v", attributeType, value)
+}
+
+// overrideValidators converts validator overrides to custom validators, which are appended to the mapped validators.
+func overrideValidators(validators []explorer.Validator) []*schema.CustomValidator {
+	customValidators := make([]*schema.CustomValidator, 0, len(validators))
+
+	for _, validator := range validators {
+		var imports []code.Import
+		for _, importPath := range validator.Imports {
+			imports = append(imports, code.Import{Path: importPath})
+		}
+
+		customValidators = append(customValidators, &schema.CustomValidator{
+			Imports:          imports,
+			SchemaDefinition: validator.SchemaDefinition,
+		})
+	}
+
+	return customValidators
+}
+
+// planModifier is the underlying type of every plan modifier in the specification, i.e. schema.StringPlanModifier
+type planModifier interface {
+	~struct {
+		Custom *schema.CustomPlanModifier `json:"custom,omitempty"`
+	}
+}
+
+type customPlanModifier = struct {
+	Custom *schema.CustomPlanModifier `json:"custom,omitempty"`
+}
+
+// overrideRequiresReplace adds or removes the RequiresReplace plan modifier, from the plan modifier package of the attribute type.
+func overrideRequiresReplace[T planModifier](planModifiers []T, requiresReplace *bool, packageName string) []T {
+	if requiresReplace == nil {
+		return planModifiers
+	}
+
+	return overridePlanModifier(planModifiers, frameworkplanmodifiers.RequiresReplace(packageName), *requiresReplace)
+}
+
+// overrideUseStateForUnknown adds or removes the UseStateForUnknown plan modifier, from the plan modifier package of the attribute type.
+func overrideUseStateForUnknown[T planModifier](planModifiers []T, useStateForUnknown *bool, packageName string) []T {
+	if useStateForUnknown == nil {
+		return planModifiers
+	}
+
+	return overridePlanModifier(planModifiers, frameworkplanmodifiers.UseStateForUnknown(packageName), *useStateForUnknown)
+}
+
+func overridePlanModifier[T planModifier](planModifiers []T, modifier *schema.CustomPlanModifier, enabled bool) []T {
+	var result []T
+
+	for _, planModifier := range planModifiers {
+		if customPlanModifier(planModifier).Custom.Equal(modifier) {
+			continue
+		}
+		result = append(result, planModifier)
+	}
+
+	if enabled {
+		result = append(result, T(customPlanModifier{Custom: modifier}))
+	}
+
+	return result
+}
+
+// convertPlanModifiers will convert framework plan modifiers from one collection plan modifier package to another, i.e.
+// `listplanmodifier.RequiresReplace()` to `setplanmodifier.RequiresReplace()`. Any other custom plan modifiers are kept as-is.
+func convertPlanModifiers[From, To planModifier](planModifiers []From, fromPackage, toPackage string) []To {
+	var result []To
+
+	for _, planModifier := range planModifiers {
+		modifier := customPlanModifier(planModifier).Custom
+		if modifier != nil && strings.HasPrefix(modifier.SchemaDefinition, fromPackage+".") {
+			modifier = &schema.CustomPlanModifier{
+				Imports:          convertImports(modifier.Imports, frameworkplanmodifiers.CodeImport(fromPackage), frameworkplanmodifiers.CodeImport(toPackage)),
+				SchemaDefinition: toPackage + strings.TrimPrefix(modifier.SchemaDefinition, fromPackage),
+			}
+		}
+
+		result = append(result, To(customPlanModifier{Custom: modifier}))
+	}
+
+	return result
+}
+
+func listToSetValidators(listValidators schema.ListValidators) schema.SetValidators {
+	var setValidators schema.SetValidators
+
+	for _, listValidator := range listValidators {
+		// Sets can't contain duplicate values, so the unique values validator is no longer needed
+		if listValidator.Custom.Equal(frameworkvalidators.ListValidatorUniqueValues()) {
+			continue
+		}
+
+		setValidators = append(setValidators, schema.SetValidator{
+			Custom: convertCollectionValidator(listValidator.Custom, frameworkvalidators.ListValidatorPackage, frameworkvalidators.SetValidatorPackage),
+		})
+	}
+
+	return setValidators
+}
+
+func setToListValidators(setValidators schema.SetValidators) schema.ListValidators {
+	var listValidators schema.ListValidators
+
+	for _, setValidator := range setValidators {
+		listValidators = append(listValidators, schema.ListValidator{
+			Custom: convertCollectionValidator(setValidator.Custom, frameworkvalidators.SetValidatorPackage, frameworkvalidators.ListValidatorPackage),
+		})
+	}
+
+	return listValidators
+}
+
+// convertCollectionValidator will convert a framework validator from one collection validator package to another, i.e.
+// `listvalidator.SizeAtLeast(1)` to `setvalidator.SizeAtLeast(1)`. Any other custom validators are returned as-is.
+func convertCollectionValidator(validator *schema.CustomValidator, fromPackage, toPackage string) *schema.CustomValidator {
+	if validator == nil || !strings.HasPrefix(validator.SchemaDefinition, fromPackage+".") {
+		return validator
+	}
+
+	return &schema.CustomValidator{
+		Imports:          convertImports(validator.Imports, frameworkvalidators.CodeImport(fromPackage), frameworkvalidators.CodeImport(toPackage)),
+		SchemaDefinition: toPackage + strings.TrimPrefix(validator.SchemaDefinition, fromPackage),
+	}
+}
+
+func convertImports(imports []code.Import, fromImport, toImport code.Import) []code.Import {
+	result := make([]code.Import, 0, len(imports))
+
+	for _, codeImport := range imports {
+		if codeImport.Path == fromImport.Path {
+			codeImport = toImport
+		}
+		result = append(result, codeImport)
+	}
+
+	return result
+}
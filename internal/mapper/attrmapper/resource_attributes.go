@@ -5,6 +5,7 @@ package attrmapper
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/explorer"
@@ -72,7 +73,9 @@ func (attributes ResourceAttributes) ApplyOverrides(overrideMap map[string]explo
 	for key, override := range overrideMap {
 		var err error
 		attributes, err = attributes.ApplyOverride(strings.Split(key, "."), override)
-		errResult = errors.Join(errResult, err)
+		if err != nil {
+			errResult = errors.Join(errResult, fmt.Errorf("failed to apply override '%s': %w", key, err))
+		}
 	}
 
 	return attributes, errResult
@@ -89,8 +92,7 @@ func (attributes ResourceAttributes) ApplyOverride(path []string, override explo
 			if len(path) > 1 {
 				nestedAttribute, ok := attribute.(ResourceNestedAttribute)
 				if !ok {
-					// TODO: error? there is a nested override for an attribute that is not a nested type
-					break
+					return attributes, fmt.Errorf("attribute '%s' is not a nested attribute", path[0])
 				}
 
 				// The attribute we need to override is deeper nested, move up
@@ -104,16 +106,16 @@ func (attributes ResourceAttributes) ApplyOverride(path []string, override explo
 			} else {
 				// No more path to traverse, apply override
 				overriddenAttribute, err := attribute.ApplyOverride(override)
-				errResult = errors.Join(errResult, err)
+				if err != nil {
+					return attributes, errors.Join(errResult, err)
+				}
 
 				attributes[i] = overriddenAttribute
 			}
 
-			break
-		} else {
-
+			return attributes, errResult
 		}
 	}
 
-	return attributes, errResult
+	return attributes, fmt.Errorf("attribute '%s' not found", path[0])
 }
@@ -207,9 +207,10 @@ func TestResourceAttributes_ApplyOverrides(t *testing.T) {
 		overrides          map[string]explorer.Override
 		attributes         attrmapper.ResourceAttributes
 		expectedAttributes attrmapper.ResourceAttributes
+		expectErr          bool
 	}{
-		// TODO: this may eventually return an error, but for now just returns without modification
 		"no matching overrides": {
+			expectErr: true,
 			overrides: map[string]explorer.Override{
 				"": {
 					Description: "new description",
@@ -301,7 +302,6 @@ func TestResourceAttributes_ApplyOverrides(t *testing.T) {
 					Name: "string_attribute",
 					StringAttribute: resource.StringAttribute{
 						ComputedOptionalRequired: schema.Computed,
-						Description:              pointer(""),
 					},
 				},
 			},
@@ -325,7 +325,6 @@ func TestResourceAttributes_ApplyOverrides(t *testing.T) {
 					Name: "string_attribute",
 					StringAttribute: resource.StringAttribute{
 						ComputedOptionalRequired: schema.Optional,
-						Description:              pointer(""),
 					},
 				},
 			},
@@ -349,7 +348,6 @@ func TestResourceAttributes_ApplyOverrides(t *testing.T) {
 					Name: "string_attribute",
 					StringAttribute: resource.StringAttribute{
 						ComputedOptionalRequired: schema.Required,
-						Description:              pointer(""),
 					},
 				},
 			},
@@ -373,7 +371,6 @@ func TestResourceAttributes_ApplyOverrides(t *testing.T) {
 					Name: "string_attribute",
 					StringAttribute: resource.StringAttribute{
 						ComputedOptionalRequired: schema.ComputedOptional,
-						Description:              pointer(""),
 					},
 				},
 			},
@@ -449,13 +446,64 @@ func TestResourceAttributes_ApplyOverrides(t *testing.T) {
 				},
 			},
 		},
+		"invalid default override type": {
+			expectErr: true,
+			overrides: map[string]explorer.Override{
+				"int64_attribute": {
+					Default: "not a number",
+				},
+			},
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceInt64Attribute{
+					Name: "int64_attribute",
+					Int64Attribute: resource.Int64Attribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceInt64Attribute{
+					Name: "int64_attribute",
+					Int64Attribute: resource.Int64Attribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+		},
+		"invalid type override": {
+			expectErr: true,
+			overrides: map[string]explorer.Override{
+				"string_attribute": {
+					Type: "set",
+				},
+			},
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_attribute",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_attribute",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		name, testCase := name, testCase
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, _ := testCase.attributes.ApplyOverrides(testCase.overrides)
+			got, err := testCase.attributes.ApplyOverrides(testCase.overrides)
+			if (err != nil) != testCase.expectErr {
+				t.Errorf("expected error: %t, got: %v", testCase.expectErr, err)
+			}
 
 			if diff := cmp.Diff(got, testCase.expectedAttributes); diff != "" {
 				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
@@ -4,11 +4,15 @@
 package attrmapper
 
 import (
+	"errors"
+
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/provider"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceSetAttribute struct {
@@ -37,13 +41,41 @@ func (a *ResourceSetAttribute) Merge(mergeAttribute ResourceAttribute) (Resource
 }
 
 func (a *ResourceSetAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	err := errors.Join(overrideCollectionType(override), overrideDefault(override, "set"))
+	if err != nil {
+		return nil, err
+	}
 
-	cor, err := ApplyComputedOptionalRequiredOverride(override.ComputedOptionalRequired)
+	a.Description = overrideString(a.Description, override.Description)
+	a.DeprecationMessage = overrideString(a.DeprecationMessage, override.DeprecationMessage)
+	a.Sensitive = overrideBool(a.Sensitive, override.Sensitive)
+	for _, validator := range overrideValidators(override.Validators) {
+		a.Validators = append(a.Validators, schema.SetValidator{Custom: validator})
+	}
+	a.PlanModifiers = overrideRequiresReplace(a.PlanModifiers, override.RequiresReplace, frameworkplanmodifiers.SetPlanModifierPackage)
+	a.PlanModifiers = overrideUseStateForUnknown(a.PlanModifiers, override.UseStateForUnknown, frameworkplanmodifiers.SetPlanModifierPackage)
+
+	a.ComputedOptionalRequired, err = overrideComputedOptionalRequired(a.ComputedOptionalRequired, override.ComputedOptionalRequired)
 	if err != nil {
 		return nil, err
 	}
-	a.ComputedOptionalRequired = cor
+
+	if override.Type == overrideTypeList {
+		return &ResourceListAttribute{
+			ListAttribute: resource.ListAttribute{
+				AssociatedExternalType:   a.AssociatedExternalType,
+				ComputedOptionalRequired: a.ComputedOptionalRequired,
+				CustomType:               a.CustomType,
+				DeprecationMessage:       a.DeprecationMessage,
+				Description:              a.Description,
+				ElementType:              a.ElementType,
+				PlanModifiers:            convertPlanModifiers[schema.SetPlanModifier, schema.ListPlanModifier](a.PlanModifiers, frameworkplanmodifiers.SetPlanModifierPackage, frameworkplanmodifiers.ListPlanModifierPackage),
+				Sensitive:                a.Sensitive,
+				Validators:               setToListValidators(a.Validators),
+			},
+			Name: a.Name,
+		}, nil
+	}
 
 	return a, nil
 }
@@ -81,7 +113,33 @@ func (a *DataSourceSetAttribute) Merge(mergeAttribute DataSourceAttribute) (Data
 }
 
 func (a *DataSourceSetAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	err := errors.Join(overrideCollectionType(override), overrideDataSource(override))
+	if err != nil {
+		return nil, err
+	}
+
+	a.Description = overrideString(a.Description, override.Description)
+	a.DeprecationMessage = overrideString(a.DeprecationMessage, override.DeprecationMessage)
+	a.Sensitive = overrideBool(a.Sensitive, override.Sensitive)
+	for _, validator := range overrideValidators(override.Validators) {
+		a.Validators = append(a.Validators, schema.SetValidator{Custom: validator})
+	}
+
+	if override.Type == overrideTypeList {
+		return &DataSourceListAttribute{
+			ListAttribute: datasource.ListAttribute{
+				AssociatedExternalType:   a.AssociatedExternalType,
+				ComputedOptionalRequired: a.ComputedOptionalRequired,
+				CustomType:               a.CustomType,
+				DeprecationMessage:       a.DeprecationMessage,
+				Description:              a.Description,
+				ElementType:              a.ElementType,
+				Sensitive:                a.Sensitive,
+				Validators:               setToListValidators(a.Validators),
+			},
+			Name: a.Name,
+		}, nil
+	}
 
 	return a, nil
 }
@@ -4,11 +4,15 @@
 package attrmapper
 
 import (
+	"errors"
+
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/provider"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceSetNestedAttribute struct {
@@ -38,13 +42,40 @@ func (a *ResourceSetNestedAttribute) Merge(mergeAttribute ResourceAttribute) (Re
 }
 
 func (a *ResourceSetNestedAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	err := errors.Join(overrideCollectionType(override), overrideDefault(override, "set_nested"))
+	if err != nil {
+		return nil, err
+	}
 
-	cor, err := ApplyComputedOptionalRequiredOverride(override.ComputedOptionalRequired)
+	a.Description = overrideString(a.Description, override.Description)
+	a.DeprecationMessage = overrideString(a.DeprecationMessage, override.DeprecationMessage)
+	a.Sensitive = overrideBool(a.Sensitive, override.Sensitive)
+	for _, validator := range overrideValidators(override.Validators) {
+		a.Validators = append(a.Validators, schema.SetValidator{Custom: validator})
+	}
+	a.PlanModifiers = overrideRequiresReplace(a.PlanModifiers, override.RequiresReplace, frameworkplanmodifiers.SetPlanModifierPackage)
+	a.PlanModifiers = overrideUseStateForUnknown(a.PlanModifiers, override.UseStateForUnknown, frameworkplanmodifiers.SetPlanModifierPackage)
+
+	a.ComputedOptionalRequired, err = overrideComputedOptionalRequired(a.ComputedOptionalRequired, override.ComputedOptionalRequired)
 	if err != nil {
 		return nil, err
 	}
-	a.ComputedOptionalRequired = cor
+
+	if override.Type == overrideTypeList {
+		return &ResourceListNestedAttribute{
+			ListNestedAttribute: resource.ListNestedAttribute{
+				ComputedOptionalRequired: a.ComputedOptionalRequired,
+				CustomType:               a.CustomType,
+				DeprecationMessage:       a.DeprecationMessage,
+				Description:              a.Description,
+				PlanModifiers:            convertPlanModifiers[schema.SetPlanModifier, schema.ListPlanModifier](a.PlanModifiers, frameworkplanmodifiers.SetPlanModifierPackage, frameworkplanmodifiers.ListPlanModifierPackage),
+				Sensitive:                a.Sensitive,
+				Validators:               setToListValidators(a.Validators),
+			},
+			Name:         a.Name,
+			NestedObject: a.NestedObject,
+		}, nil
+	}
 
 	return a, nil
 }
@@ -94,7 +125,32 @@ func (a *DataSourceSetNestedAttribute) Merge(mergeAttribute DataSourceAttribute)
 }
 
 func (a *DataSourceSetNestedAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	err := errors.Join(overrideCollectionType(override), overrideDataSource(override))
+	if err != nil {
+		return nil, err
+	}
+
+	a.Description = overrideString(a.Description, override.Description)
+	a.DeprecationMessage = overrideString(a.DeprecationMessage, override.DeprecationMessage)
+	a.Sensitive = overrideBool(a.Sensitive, override.Sensitive)
+	for _, validator := range overrideValidators(override.Validators) {
+		a.Validators = append(a.Validators, schema.SetValidator{Custom: validator})
+	}
+
+	if override.Type == overrideTypeList {
+		return &DataSourceListNestedAttribute{
+			ListNestedAttribute: datasource.ListNestedAttribute{
+				ComputedOptionalRequired: a.ComputedOptionalRequired,
+				CustomType:               a.CustomType,
+				DeprecationMessage:       a.DeprecationMessage,
+				Description:              a.Description,
+				Sensitive:                a.Sensitive,
+				Validators:               setToListValidators(a.Validators),
+			},
+			Name:         a.Name,
+			NestedObject: a.NestedObject,
+		}, nil
+	}
 
 	return a, nil
 }
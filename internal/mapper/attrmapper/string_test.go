@@ -8,6 +8,9 @@ import (
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkcustomtypes"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
@@ -111,6 +114,28 @@ func TestResourceStringAttribute_Merge(t *testing.T) {
 				},
 			},
 		},
+		"nil custom type - merge": {
+			targetAttribute: attrmapper.ResourceStringAttribute{
+				Name: "string_attribute",
+				StringAttribute: resource.StringAttribute{
+					ComputedOptionalRequired: schema.Required,
+				},
+			},
+			mergeAttribute: &attrmapper.ResourceStringAttribute{
+				Name: "string_attribute",
+				StringAttribute: resource.StringAttribute{
+					ComputedOptionalRequired: schema.Computed,
+					CustomType:               frameworkcustomtypes.RFC3339(),
+				},
+			},
+			expectedAttribute: &attrmapper.ResourceStringAttribute{
+				Name: "string_attribute",
+				StringAttribute: resource.StringAttribute{
+					ComputedOptionalRequired: schema.Required,
+					CustomType:               frameworkcustomtypes.RFC3339(),
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		name, testCase := name, testCase
@@ -153,6 +178,94 @@ func TestResourceStringAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override sensitive, deprecation message, validators, and default": {
+			attribute: attrmapper.ResourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: resource.StringAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{
+				Sensitive:          pointer(true),
+				DeprecationMessage: "use other_attribute instead",
+				Default:            "default value",
+				Validators: []explorer.Validator{
+					{
+						SchemaDefinition: "stringvalidator.LengthAtLeast(1)",
+						Imports:          []string{"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"},
+					},
+				},
+			},
+			expectedAttribute: &attrmapper.ResourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: resource.StringAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					Default: &schema.StringDefault{
+						Static: pointer("default value"),
+					},
+					DeprecationMessage: pointer("use other_attribute instead"),
+					Description:        pointer("old description"),
+					Sensitive:          pointer(true),
+					Validators: schema.StringValidators{
+						{
+							Custom: &schema.CustomValidator{
+								Imports: []code.Import{
+									{
+										Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+									},
+								},
+								SchemaDefinition: "stringvalidator.LengthAtLeast(1)",
+							},
+						},
+					},
+				},
+			},
+		},
+		"override requires replace - remove": {
+			attribute: attrmapper.ResourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: resource.StringAttribute{
+					ComputedOptionalRequired: schema.Required,
+					PlanModifiers: schema.StringPlanModifiers{
+						{
+							Custom: frameworkplanmodifiers.RequiresReplace(frameworkplanmodifiers.StringPlanModifierPackage),
+						},
+					},
+				},
+			},
+			override: explorer.Override{
+				RequiresReplace: pointer(false),
+			},
+			expectedAttribute: &attrmapper.ResourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: resource.StringAttribute{
+					ComputedOptionalRequired: schema.Required,
+				},
+			},
+		},
+		"override use state for unknown - add": {
+			attribute: attrmapper.ResourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: resource.StringAttribute{
+					ComputedOptionalRequired: schema.Computed,
+				},
+			},
+			override: explorer.Override{
+				UseStateForUnknown: pointer(true),
+			},
+			expectedAttribute: &attrmapper.ResourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: resource.StringAttribute{
+					ComputedOptionalRequired: schema.Computed,
+					PlanModifiers: schema.StringPlanModifiers{
+						{
+							Custom: frameworkplanmodifiers.UseStateForUnknown(frameworkplanmodifiers.StringPlanModifierPackage),
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		name, testCase := name, testCase
@@ -307,6 +420,28 @@ func TestDataSourceStringAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override sensitive and deprecation message": {
+			attribute: attrmapper.DataSourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: datasource.StringAttribute{
+					ComputedOptionalRequired: schema.Computed,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{
+				Sensitive:          pointer(true),
+				DeprecationMessage: "use other_attribute instead",
+			},
+			expectedAttribute: &attrmapper.DataSourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: datasource.StringAttribute{
+					ComputedOptionalRequired: schema.Computed,
+					DeprecationMessage:       pointer("use other_attribute instead"),
+					Description:              pointer("old description"),
+					Sensitive:                pointer(true),
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		name, testCase := name, testCase
@@ -0,0 +1,256 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/oas"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
+
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+// ValidateConfig validates a generator config against an OpenAPI spec, which should be run after config.Validate. Every operation
+// location is resolved, and every `ignores`, `overrides`, and `aliases` entry is checked to match a property or parameter in the
+// resolved operations. All errors are returned together, prefixed with the line number in the generator config.
+func ValidateConfig(spec high.Document, cfg config.Config) error {
+	errResult := validateProviderConfig(spec, cfg)
+
+	for _, name := range util.SortedKeys(cfg.Resources) {
+		errResult = errors.Join(errResult, validateResourceConfig(spec, cfg, name))
+	}
+
+	for _, name := range util.SortedKeys(cfg.DataSources) {
+		errResult = errors.Join(errResult, validateDataSourceConfig(spec, cfg, name))
+	}
+
+	return errResult
+}
+
+// validateProviderConfig checks that the provider `schema_ref` exists, and every provider ignore matches a property path of its schema.
+func validateProviderConfig(spec high.Document, cfg config.Config) error {
+	explorerProvider, err := explorer.NewConfigExplorer(spec, config.Config{
+		Provider: cfg.Provider,
+	}).FindProvider()
+	if err != nil {
+		return configError(cfg, fmt.Errorf("provider invalid schema_ref: %w", err), "provider", "schema_ref")
+	}
+
+	propertyPaths := map[string]bool{}
+	if explorerProvider.SchemaProxy != nil {
+		providerSchema, err := oas.BuildSchema(explorerProvider.SchemaProxy, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+		if err != nil {
+			return configError(cfg, fmt.Errorf("provider invalid schema_ref: %w", err), "provider", "schema_ref")
+		}
+		addPropertyPaths(providerSchema, "", propertyPaths)
+	}
+
+	var errResult error
+	for i, ignore := range cfg.Provider.Ignores {
+		if !matchesAnyPropertyPath(ignore, propertyPaths) {
+			errResult = errors.Join(errResult, configError(cfg,
+				fmt.Errorf("provider invalid ignores: %q does not match any attribute", ignore),
+				"provider", "ignores", strconv.Itoa(i)))
+		}
+	}
+
+	return errResult
+}
+
+func validateResourceConfig(spec high.Document, cfg config.Config, name string) error {
+	var errResult error
+	resourceConfig := cfg.Resources[name]
+
+	type operationLocation struct {
+		name     string
+		keys     []string
+		location *config.OpenApiSpecLocation
+	}
+
+	locations := []operationLocation{
+		{name: "create", keys: []string{"create"}, location: resourceConfig.Create},
+		{name: "read", keys: []string{"read"}, location: resourceConfig.Read},
+	}
+	for i, update := range resourceConfig.Update {
+		locations = append(locations, operationLocation{name: fmt.Sprintf("update[%d]", i), keys: []string{"update", strconv.Itoa(i)}, location: update})
+	}
+	locations = append(locations, operationLocation{name: "delete", keys: []string{"delete"}, location: resourceConfig.Delete})
+
+	for _, loc := range locations {
+		if loc.location == nil {
+			continue
+		}
+
+		_, _, err := explorer.FindOperation(spec, loc.location)
+		if err != nil {
+			errResult = errors.Join(errResult, configError(cfg, fmt.Errorf("resource '%s' invalid %s: %w", name, loc.name, err), append([]string{"resources", name}, loc.keys...)...))
+		}
+	}
+
+	// Attributes can't be checked if any operations are missing
+	if errResult != nil {
+		return errResult
+	}
+
+	explorerResources, err := explorer.NewConfigExplorer(spec, config.Config{
+		Resources: map[string]config.Resource{name: resourceConfig},
+	}).FindResources()
+	if err != nil {
+		return configError(cfg, fmt.Errorf("resource '%s' %w", name, err), "resources", name)
+	}
+	explorerResource := explorerResources[name]
+	aliases := explorerResource.SchemaOptions.AttributeOptions.Aliases
+
+	propertyPaths := map[string]bool{}
+	parameterNames := map[string]bool{}
+
+	if explorerResource.SchemaOptions.CreateFromParameters {
+		addParameterPaths(explorerResource.CreateOpParameters(), aliases, propertyPaths, parameterNames)
+	} else {
+		createRequestSchema, err := oas.BuildSchemaFromRequest(explorerResource.CreateOp, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+		if err == nil {
+			addPropertyPaths(createRequestSchema, "", propertyPaths)
+		}
+	}
+
+	createResponseSchema, err := oas.BuildSchemaFromResponsePath(explorerResource.CreateOp, explorerResource.CreateResponsePath, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+	if err == nil {
+		addPropertyPaths(createResponseSchema, "", propertyPaths)
+	} else if explorerResource.CreateResponsePath != "" && !errors.Is(err, oas.ErrSchemaNotFound) {
+		errResult = errors.Join(errResult, configError(cfg, fmt.Errorf("resource '%s' invalid create: %w", name, err), "resources", name, "create", "response_path"))
+	}
+
+	readResponseSchema, err := oas.BuildSchemaFromResponsePath(explorerResource.ReadOp, explorerResource.ReadResponsePath, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+	if err == nil {
+		addPropertyPaths(readResponseSchema, "", propertyPaths)
+	} else if explorerResource.ReadResponsePath != "" && !errors.Is(err, oas.ErrSchemaNotFound) {
+		errResult = errors.Join(errResult, configError(cfg, fmt.Errorf("resource '%s' invalid read: %w", name, err), "resources", name, "read", "response_path"))
+	}
+
+	addParameterPaths(explorerResource.ReadOpParameters(), aliases, propertyPaths, parameterNames)
+
+	errResult = errors.Join(errResult, validateSchemaOptions(cfg, resourceConfig.SchemaOptions, propertyPaths, parameterNames, "resource", "resources", name))
+
+	return errResult
+}
+
+func validateDataSourceConfig(spec high.Document, cfg config.Config, name string) error {
+	dataSourceConfig := cfg.DataSources[name]
+
+	if dataSourceConfig.Read == nil {
+		return nil
+	}
+
+	_, _, err := explorer.FindOperation(spec, dataSourceConfig.Read)
+	if err != nil {
+		return configError(cfg, fmt.Errorf("data_source '%s' invalid read: %w", name, err), "datasources", name, "read")
+	}
+
+	explorerDataSources, err := explorer.NewConfigExplorer(spec, config.Config{
+		DataSources: map[string]config.DataSource{name: dataSourceConfig},
+	}).FindDataSources()
+	if err != nil {
+		return configError(cfg, fmt.Errorf("data_source '%s' %w", name, err), "datasources", name)
+	}
+	explorerDataSource := explorerDataSources[name]
+
+	var errResult error
+	propertyPaths := map[string]bool{}
+	parameterNames := map[string]bool{}
+
+	readResponseSchema, err := oas.BuildSchemaFromResponsePath(explorerDataSource.ReadOp, explorerDataSource.ReadResponsePath, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+	if err == nil {
+		// Collection data sources are mapped to a single attribute, named after the data source
+		if readResponseSchema.Type == util.OAS_type_array {
+			propertyPaths[name] = true
+			addPropertyPaths(readResponseSchema, name+".", propertyPaths)
+		} else {
+			addPropertyPaths(readResponseSchema, "", propertyPaths)
+		}
+	} else if explorerDataSource.ReadResponsePath != "" && !errors.Is(err, oas.ErrSchemaNotFound) {
+		errResult = errors.Join(errResult, configError(cfg, fmt.Errorf("data_source '%s' invalid read: %w", name, err), "datasources", name, "read", "response_path"))
+	}
+
+	addParameterPaths(explorerDataSource.ReadOpParameters(), explorerDataSource.SchemaOptions.AttributeOptions.Aliases, propertyPaths, parameterNames)
+
+	errResult = errors.Join(errResult, validateSchemaOptions(cfg, dataSourceConfig.SchemaOptions, propertyPaths, parameterNames, "data_source", "datasources", name))
+
+	return errResult
+}
+
+// validateSchemaOptions checks that every ignore and override matches a property path, and every alias matches a parameter name.
+func validateSchemaOptions(cfg config.Config, schemaOptions config.SchemaOptions, propertyPaths, parameterNames map[string]bool, kind, section, name string) error {
+	var errResult error
+
+	for i, ignore := range schemaOptions.Ignores {
+		if !matchesAnyPropertyPath(ignore, propertyPaths) {
+			errResult = errors.Join(errResult, configError(cfg,
+				fmt.Errorf("%s '%s' invalid ignores: %q does not match any attribute", kind, name, ignore),
+				section, name, "schema", "ignores", strconv.Itoa(i)))
+		}
+	}
+
+	for _, key := range util.SortedKeys(schemaOptions.AttributeOptions.Overrides) {
+		if !propertyPaths[key] {
+			errResult = errors.Join(errResult, configError(cfg,
+				fmt.Errorf("%s '%s' invalid overrides: %q does not match any attribute", kind, name, key),
+				section, name, "schema", "attributes", "overrides", key))
+		}
+	}
+
+	for _, key := range util.SortedKeys(schemaOptions.AttributeOptions.Aliases) {
+		if !parameterNames[key] {
+			errResult = errors.Join(errResult, configError(cfg,
+				fmt.Errorf("%s '%s' invalid aliases: %q does not match any parameter", kind, name, key),
+				section, name, "schema", "attributes", "aliases", key))
+		}
+	}
+
+	return errResult
+}
+
+// matchesAnyPropertyPath returns true if an ignore, which may contain wildcards, matches at least one property path.
+func matchesAnyPropertyPath(ignore string, propertyPaths map[string]bool) bool {
+	for path := range propertyPaths {
+		if util.MatchIgnore(ignore, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func addPropertyPaths(s *oas.OASSchema, prefix string, propertyPaths map[string]bool) {
+	for _, path := range s.PropertyPaths() {
+		propertyPaths[prefix+path] = true
+	}
+}
+
+// addParameterPaths adds the `query` and `path` parameters, which are mapped to attributes with their aliased name.
+func addParameterPaths(params []*high.Parameter, aliases map[string]string, propertyPaths, parameterNames map[string]bool) {
+	for _, param := range params {
+		if param.In != util.OAS_param_path && param.In != util.OAS_param_query {
+			continue
+		}
+
+		parameterNames[param.Name] = true
+
+		paramName := param.Name
+		if aliasedName, ok := aliases[param.Name]; ok {
+			paramName = aliasedName
+		}
+		propertyPaths[paramName] = true
+
+		s, err := oas.BuildSchema(param.Schema, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+		if err == nil {
+			addPropertyPaths(s, paramName+".", propertyPaths)
+		}
+	}
+}
+
+func configError(cfg config.Config, err error, keys ...string) error {
+	return fmt.Errorf("\tline %d: %w", cfg.Line(keys...), err)
+}
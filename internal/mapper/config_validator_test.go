@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper_test
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper"
+
+	"github.com/pb33f/libopenapi"
+)
+
+const testValidateConfigSpec = `openapi: 3.0.3
+info:
+  title: Validate API
+  version: 1.0.0
+paths:
+  /things:
+    post:
+      operationId: createThing
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                name:
+                  type: string
+                tags:
+                  type: array
+                  items:
+                    type: object
+                    properties:
+                      key:
+                        type: string
+  /things/{thing_id}:
+    parameters:
+      - name: thing_id
+        in: path
+        required: true
+        schema:
+          type: string
+    get:
+      operationId: getThing
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Thing'
+    delete:
+      operationId: deleteThing
+      responses:
+        '204':
+          description: No Content
+components:
+  schemas:
+    Thing:
+      type: object
+      properties:
+        id:
+          type: string
+        parent:
+          $ref: '#/components/schemas/Thing'
+`
+
+func TestValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config      string
+		expectedErr string
+	}{
+		"valid": {
+			config: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+resources:
+  thing:
+    create:
+      path: /things
+      method: POST
+    read:
+      operation_id: getThing
+    delete:
+      path: /things/{thing_id}
+      method: DELETE
+    schema:
+      ignores:
+        - tags.key
+        - parent.id
+      attributes:
+        aliases:
+          thing_id: id
+        overrides:
+          name:
+            description: overridden
+          tags:
+            description: overridden
+
+datasources:
+  thing:
+    read:
+      path: /things/{thing_id}
+      method: GET
+    schema:
+      ignores:
+        - parent`,
+		},
+		"invalid locations": {
+			config: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+resources:
+  thing:
+    create:
+      path: /thingz
+      method: POST
+    read:
+      operation_id: getThingz
+    update:
+      - path: /things/{thing_id}
+        method: PUT
+    delete:
+      path: /things/{thing_id}
+      method: FAKE
+
+datasources:
+  thing:
+    read:
+      path: /things
+      method: GET`,
+			expectedErr: "\tline 8: resource 'thing' invalid create: path '/thingz' not found in OpenAPI spec\n" +
+				"\tline 11: resource 'thing' invalid read: operation_id 'getThingz' not found in OpenAPI spec\n" +
+				"\tline 14: resource 'thing' invalid update[0]: method 'PUT' not found at OpenAPI path '/things/{thing_id}'\n" +
+				"\tline 16: resource 'thing' invalid delete: method 'FAKE' not found at OpenAPI path '/things/{thing_id}'\n" +
+				"\tline 22: data_source 'thing' invalid read: method 'GET' not found at OpenAPI path '/things'",
+		},
+		"invalid attributes": {
+			config: `
+provider:
+  name: example
+  endpoint: https://example.com
+
+resources:
+  thing:
+    create:
+      path: /things
+      method: POST
+    read:
+      path: /things/{thing_id}
+      method: GET
+      response_path: /thing
+    delete:
+      path: /things/{thing_id}
+      method: DELETE
+    schema:
+      ignores:
+        - name
+        - tags.value
+      attributes:
+        aliases:
+          id: thing_id
+        overrides:
+          nmae:
+            description: typo`,
+			expectedErr: "\tline 14: resource 'thing' invalid read: response path '/thing' - property 'thing' not found\n" +
+				"\tline 21: resource 'thing' invalid ignores: \"tags.value\" does not match any attribute\n" +
+				"\tline 26: resource 'thing' invalid overrides: \"nmae\" does not match any attribute\n" +
+				"\tline 24: resource 'thing' invalid aliases: \"id\" does not match any parameter",
+		},
+		"provider ignores": {
+			config: `
+provider:
+  name: example
+  endpoint: https://example.com
+  schema_ref: '#/components/schemas/Thing'
+  ignores:
+    - parent.id
+    - nmae
+
+datasources:
+  thing:
+    read:
+      path: /things/{thing_id}
+      method: GET`,
+			expectedErr: "\tline 8: provider invalid ignores: \"nmae\" does not match any attribute",
+		},
+		"provider ignores without schema_ref": {
+			config: `
+provider:
+  name: example
+  endpoint: https://example.com
+  ignores:
+    - id
+
+datasources:
+  thing:
+    read:
+      path: /things/{thing_id}
+      method: GET`,
+			expectedErr: "\tline 6: provider invalid ignores: \"id\" does not match any attribute",
+		},
+		"invalid provider schema_ref": {
+			config: `
+provider:
+  name: example
+  endpoint: https://example.com
+  schema_ref: '#/components/schemas/Thingz'
+  ignores:
+    - id
+
+datasources:
+  thing:
+    read:
+      path: /things/{thing_id}
+      method: GET`,
+			expectedErr: "\tline 5: provider invalid schema_ref: error extracting provider schema from ref: unable to find reference: #/components/schemas/Thingz",
+		},
+	}
+
+	doc, err := libopenapi.NewDocument([]byte(testValidateConfigSpec))
+	if err != nil {
+		t.Fatalf("unexpected error parsing spec: %s", err)
+	}
+	model, errs := doc.BuildV3Model()
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors building model: %v", errs)
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg, err := config.ParseConfig([]byte(testCase.config))
+			if err != nil {
+				t.Fatalf("unexpected error parsing config: %s", err)
+			}
+
+			err = mapper.ValidateConfig(model.Model, *cfg)
+
+			if testCase.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got nil", testCase.expectedErr)
+			}
+
+			if err.Error() != testCase.expectedErr {
+				t.Errorf("expected error:\n%s\ngot:\n%s", testCase.expectedErr, err)
+			}
+		})
+	}
+}
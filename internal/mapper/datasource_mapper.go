@@ -84,7 +84,7 @@ func (m dataSourceMapper) MapToIR(logger *slog.Logger) ([]DetailDataSourceInfo,
 			refreshObjectName = s[len(s)-1]
 		}
 
-		schema, err := generateDataSourceSchema(dLogger, name, dataSource)
+		schema, err := generateDataSourceSchema(dLogger, name, dataSource, m.cfg)
 		if err != nil {
 			log.WarnLogOnError(dLogger, err, "skipping data source schema mapping")
 			continue
@@ -105,7 +105,7 @@ func (m dataSourceMapper) MapToIR(logger *slog.Logger) ([]DetailDataSourceInfo,
 	return dataSourceSchemas, nil
 }
 
-func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explorer.DataSource) (*datasource.Schema, error) {
+func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explorer.DataSource, cfg config.Config) (*datasource.Schema, error) {
 	dataSourceSchema := &datasource.Schema{
 		Attributes: []datasource.Attribute{},
 	}
@@ -118,10 +118,8 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 	schemaOpts := oas.SchemaOpts{
 		Ignores: dataSource.SchemaOptions.Ignores,
 	}
-	globalSchemaOpts := oas.GlobalSchemaOpts{
-		OverrideComputability: schema.Computed,
-	}
-	readResponseSchema, err := oas.BuildSchemaFromResponse(dataSource.ReadOp, schemaOpts, globalSchemaOpts)
+	globalSchemaOpts := newGlobalSchemaOpts(cfg, schema.Computed)
+	readResponseSchema, err := oas.BuildSchemaFromResponsePath(dataSource.ReadOp, dataSource.ReadResponsePath, schemaOpts, globalSchemaOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +161,7 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 			OverrideDescription: param.Description,
 		}
 
-		s, schemaErr := oas.BuildSchema(param.Schema, schemaOpts, oas.GlobalSchemaOpts{})
+		s, schemaErr := oas.BuildSchema(param.Schema, schemaOpts, newGlobalSchemaOpts(cfg, ""))
 		if schemaErr != nil {
 			log.WarnLogOnError(pLogger, schemaErr, "skipping mapping of read operation parameter")
 			continue
@@ -197,8 +195,8 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 	// TODO: currently, no errors can be returned from merging, but in the future we should consider raising errors/warnings for unexpected scenarios, like type mismatches between attribute schemas
 	dataSourceAttributes, _ := readParameterAttributes.Merge(readResponseAttributes)
 
-	// TODO: handle error for overrides
-	dataSourceAttributes, _ = dataSourceAttributes.ApplyOverrides(dataSource.SchemaOptions.AttributeOptions.Overrides)
+	dataSourceAttributes, err = dataSourceAttributes.ApplyOverrides(dataSource.SchemaOptions.AttributeOptions.Overrides)
+	log.WarnLogOnError(logger, err, "skipping invalid attribute overrides")
 
 	dataSourceSchema.Attributes = dataSourceAttributes.ToSpec()
 	return dataSourceSchema, nil
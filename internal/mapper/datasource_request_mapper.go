@@ -56,11 +56,11 @@ func generateRequestDataSourceType(logger *slog.Logger, explorerDataSource explo
 			RequestType: spec.RequestType{
 				Response: response,
 			},
-			Parameters:  extractParametersInfo(explorerDataSource.ReadOp),
+			Parameters:  extractParametersInfo(operationParameters(explorerDataSource.ReadOp)),
 			RequestBody: requestBody,
 		},
-		Method: config.DataSources[name].Read.Method,
-		Path:   config.DataSources[name].Read.Path,
+		Method: explorerDataSource.ReadLocation.Method,
+		Path:   explorerDataSource.ReadLocation.Path,
 	}
 
 	return CRUDParameters{
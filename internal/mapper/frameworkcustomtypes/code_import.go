@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkcustomtypes
+
+import "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+
+const (
+	// TimeTypesCodeImportPath is the code import path for terraform-plugin-framework-timetypes.
+	TimeTypesCodeImportPath = "github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+
+	// IPTypesCodeImportPath is the code import path for IP address types in terraform-plugin-framework-nettypes.
+	IPTypesCodeImportPath = "github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+
+	// CIDRTypesCodeImportPath is the code import path for CIDR types in terraform-plugin-framework-nettypes.
+	CIDRTypesCodeImportPath = "github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+
+	// JSONTypesCodeImportPath is the code import path for terraform-plugin-framework-jsontypes.
+	JSONTypesCodeImportPath = "github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+// CodeImport returns the custom type code import for the given path.
+func CodeImport(importPath string) *code.Import {
+	return &code.Import{
+		Path: importPath,
+	}
+}
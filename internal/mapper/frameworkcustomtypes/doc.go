@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package frameworkcustomtypes contains functionality for mapping string formats
+// onto specification that uses terraform-plugin-framework custom types, such as
+// terraform-plugin-framework-timetypes, terraform-plugin-framework-nettypes, and
+// terraform-plugin-framework-jsontypes.
+//
+// The custom types implement semantic equality, so values that are formatted
+// differently by the API, but are equivalent, will not cause a difference.
+package frameworkcustomtypes
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkcustomtypes
+
+import "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+
+// NormalizedJSON returns a custom type for a string containing JSON, which ignores whitespace and key ordering differences.
+func NormalizedJSON() *schema.CustomType {
+	return &schema.CustomType{
+		Import:    CodeImport(JSONTypesCodeImportPath),
+		Type:      "jsontypes.NormalizedType{}",
+		ValueType: "jsontypes.Normalized",
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkcustomtypes
+
+import "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+
+// IPv4Address returns a custom type for an IPv4 address string, i.e. `format: ipv4`.
+func IPv4Address() *schema.CustomType {
+	return &schema.CustomType{
+		Import:    CodeImport(IPTypesCodeImportPath),
+		Type:      "iptypes.IPv4AddressType{}",
+		ValueType: "iptypes.IPv4Address",
+	}
+}
+
+// IPv6Address returns a custom type for an IPv6 address string, i.e. `format: ipv6`.
+func IPv6Address() *schema.CustomType {
+	return &schema.CustomType{
+		Import:    CodeImport(IPTypesCodeImportPath),
+		Type:      "iptypes.IPv6AddressType{}",
+		ValueType: "iptypes.IPv6Address",
+	}
+}
+
+// IPv4Prefix returns a custom type for an IPv4 CIDR string, i.e. `format: ipv4-cidr`.
+func IPv4Prefix() *schema.CustomType {
+	return &schema.CustomType{
+		Import:    CodeImport(CIDRTypesCodeImportPath),
+		Type:      "cidrtypes.IPv4PrefixType{}",
+		ValueType: "cidrtypes.IPv4Prefix",
+	}
+}
+
+// IPv6Prefix returns a custom type for an IPv6 CIDR string, i.e. `format: ipv6-cidr`.
+func IPv6Prefix() *schema.CustomType {
+	return &schema.CustomType{
+		Import:    CodeImport(CIDRTypesCodeImportPath),
+		Type:      "cidrtypes.IPv6PrefixType{}",
+		ValueType: "cidrtypes.IPv6Prefix",
+	}
+}
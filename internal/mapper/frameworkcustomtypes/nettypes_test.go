@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkcustomtypes_test
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkcustomtypes"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNetTypes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		customType func() *schema.CustomType
+		expected   *schema.CustomType
+	}{
+		"IPv4Address": {
+			customType: frameworkcustomtypes.IPv4Address,
+			expected: &schema.CustomType{
+				Import: &code.Import{
+					Path: "github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes",
+				},
+				Type:      "iptypes.IPv4AddressType{}",
+				ValueType: "iptypes.IPv4Address",
+			},
+		},
+		"IPv6Address": {
+			customType: frameworkcustomtypes.IPv6Address,
+			expected: &schema.CustomType{
+				Import: &code.Import{
+					Path: "github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes",
+				},
+				Type:      "iptypes.IPv6AddressType{}",
+				ValueType: "iptypes.IPv6Address",
+			},
+		},
+		"IPv4Prefix": {
+			customType: frameworkcustomtypes.IPv4Prefix,
+			expected: &schema.CustomType{
+				Import: &code.Import{
+					Path: "github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes",
+				},
+				Type:      "cidrtypes.IPv4PrefixType{}",
+				ValueType: "cidrtypes.IPv4Prefix",
+			},
+		},
+		"IPv6Prefix": {
+			customType: frameworkcustomtypes.IPv6Prefix,
+			expected: &schema.CustomType{
+				Import: &code.Import{
+					Path: "github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes",
+				},
+				Type:      "cidrtypes.IPv6PrefixType{}",
+				ValueType: "cidrtypes.IPv6Prefix",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.customType()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
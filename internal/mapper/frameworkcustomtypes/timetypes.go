@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkcustomtypes
+
+import "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+
+// RFC3339 returns a custom type for an RFC 3339 timestamp string, i.e. `format: date-time`.
+func RFC3339() *schema.CustomType {
+	return &schema.CustomType{
+		Import:    CodeImport(TimeTypesCodeImportPath),
+		Type:      "timetypes.RFC3339Type{}",
+		ValueType: "timetypes.RFC3339",
+	}
+}
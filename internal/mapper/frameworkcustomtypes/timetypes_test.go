@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkcustomtypes_test
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkcustomtypes"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRFC3339(t *testing.T) {
+	t.Parallel()
+
+	expected := &schema.CustomType{
+		Import: &code.Import{
+			Path: "github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes",
+		},
+		Type:      "timetypes.RFC3339Type{}",
+		ValueType: "timetypes.RFC3339",
+	}
+
+	got := frameworkcustomtypes.RFC3339()
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers
+
+import (
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+)
+
+// Names of the plan modifier packages in the framework module, one for each attribute type.
+const (
+	BoolPlanModifierPackage    = "boolplanmodifier"
+	Float64PlanModifierPackage = "float64planmodifier"
+	Int32PlanModifierPackage   = "int32planmodifier"
+	Int64PlanModifierPackage   = "int64planmodifier"
+	ListPlanModifierPackage    = "listplanmodifier"
+	MapPlanModifierPackage     = "mapplanmodifier"
+	NumberPlanModifierPackage  = "numberplanmodifier"
+	ObjectPlanModifierPackage  = "objectplanmodifier"
+	SetPlanModifierPackage     = "setplanmodifier"
+	StringPlanModifierPackage  = "stringplanmodifier"
+)
+
+// RequiresReplace returns a custom plan modifier mapped to the RequiresReplace
+// function of the given plan modifier package.
+func RequiresReplace(packageName string) *schema.CustomPlanModifier {
+	return &schema.CustomPlanModifier{
+		Imports: []code.Import{
+			CodeImport(packageName),
+		},
+		SchemaDefinition: packageName + ".RequiresReplace()",
+	}
+}
+
+// UseStateForUnknown returns a custom plan modifier mapped to the
+// UseStateForUnknown function of the given plan modifier package.
+func UseStateForUnknown(packageName string) *schema.CustomPlanModifier {
+	return &schema.CustomPlanModifier{
+		Imports: []code.Import{
+			CodeImport(packageName),
+		},
+		SchemaDefinition: packageName + ".UseStateForUnknown()",
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers_test
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		packageName string
+		expected    *schema.CustomPlanModifier
+	}{
+		"string": {
+			packageName: frameworkplanmodifiers.StringPlanModifierPackage,
+			expected: &schema.CustomPlanModifier{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+					},
+				},
+				SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+			},
+		},
+		"list": {
+			packageName: frameworkplanmodifiers.ListPlanModifierPackage,
+			expected: &schema.CustomPlanModifier{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier",
+					},
+				},
+				SchemaDefinition: "listplanmodifier.RequiresReplace()",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkplanmodifiers.RequiresReplace(testCase.packageName)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUseStateForUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		packageName string
+		expected    *schema.CustomPlanModifier
+	}{
+		"string": {
+			packageName: frameworkplanmodifiers.StringPlanModifierPackage,
+			expected: &schema.CustomPlanModifier{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+					},
+				},
+				SchemaDefinition: "stringplanmodifier.UseStateForUnknown()",
+			},
+		},
+		"object": {
+			packageName: frameworkplanmodifiers.ObjectPlanModifierPackage,
+			expected: &schema.CustomPlanModifier{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier",
+					},
+				},
+				SchemaDefinition: "objectplanmodifier.UseStateForUnknown()",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkplanmodifiers.UseStateForUnknown(testCase.packageName)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators
+
+import "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+
+const (
+	// BoolValidatorPackage is the name of the bool validation package in
+	// the framework validators module.
+	BoolValidatorPackage = "boolvalidator"
+)
+
+var (
+	// BoolValidatorCodeImport is a single allocation of the framework
+	// validators module boolvalidator package import.
+	BoolValidatorCodeImport code.Import = CodeImport(BoolValidatorPackage)
+)
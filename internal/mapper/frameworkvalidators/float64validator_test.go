@@ -65,3 +65,149 @@ func TestFloat64ValidatorOneOf(t *testing.T) {
 		})
 	}
 }
+
+func TestFloat64ValidatorAtLeast(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		min      float64
+		expected *schema.CustomValidator
+	}{
+		"test": {
+			min: 1.5,
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+					},
+				},
+				SchemaDefinition: "float64validator.AtLeast(1.5)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.Float64ValidatorAtLeast(testCase.min)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat64ValidatorAtMost(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		max      float64
+		expected *schema.CustomValidator
+	}{
+		"test": {
+			max: 123,
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+					},
+				},
+				SchemaDefinition: "float64validator.AtMost(123)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.Float64ValidatorAtMost(testCase.max)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat64ValidatorBetween(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		min      float64
+		max      float64
+		expected *schema.CustomValidator
+	}{
+		"test": {
+			min: 0.5,
+			max: 99.9,
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+					},
+				},
+				SchemaDefinition: "float64validator.Between(0.5, 99.9)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.Float64ValidatorBetween(testCase.min, testCase.max)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat64ValidatorNoneOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		values   []float64
+		expected *schema.CustomValidator
+	}{
+		"nil": {
+			values:   nil,
+			expected: nil,
+		},
+		"multiple": {
+			values: []float64{0, 1.5},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+					},
+				},
+				SchemaDefinition: "float64validator.NoneOf(\n0,\n1.5,\n)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.Float64ValidatorNoneOf(testCase.values)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
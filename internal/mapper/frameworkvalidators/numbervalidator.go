@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators
+
+import "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+
+const (
+	// NumberValidatorPackage is the name of the number validation package in
+	// the framework validators module.
+	NumberValidatorPackage = "numbervalidator"
+)
+
+var (
+	// NumberValidatorCodeImport is a single allocation of the framework
+	// validators module numbervalidator package import.
+	NumberValidatorCodeImport code.Import = CodeImport(NumberValidatorPackage)
+)
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators
+
+import (
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// ObjectValidatorPackage is the name of the object validation package in
+	// the framework validators module.
+	ObjectValidatorPackage = "objectvalidator"
+
+	// PathPackage is the name of the path package in the framework module.
+	PathPackage = "path"
+)
+
+var (
+	// ObjectValidatorCodeImport is a single allocation of the framework
+	// validators module objectvalidator package import.
+	ObjectValidatorCodeImport code.Import = CodeImport(ObjectValidatorPackage)
+
+	// PathCodeImport is a single allocation of the framework module path
+	// package import, used for path expressions in validators.
+	PathCodeImport code.Import = code.Import{
+		Path: "github.com/hashicorp/terraform-plugin-framework/path",
+	}
+)
+
+// ObjectValidatorExactlyOneOf returns a custom validator mapped to the
+// objectvalidator package ExactlyOneOf function. Each attribute name is
+// written as a path expression relative to the parent of the validated
+// attribute, i.e. a sibling attribute. If the attribute names are nil or
+// empty, nil is returned.
+func ObjectValidatorExactlyOneOf(attributeNames []string) *schema.CustomValidator {
+	return PathExpressionsValidator(ObjectValidatorPackage, ExactlyOneOfFunction, attributeNames)
+}
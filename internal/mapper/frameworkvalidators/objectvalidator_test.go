@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators_test
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestObjectValidatorExactlyOneOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributeNames []string
+		expected       *schema.CustomValidator
+	}{
+		"nil": {
+			attributeNames: nil,
+			expected:       nil,
+		},
+		"empty": {
+			attributeNames: []string{},
+			expected:       nil,
+		},
+		"multiple": {
+			attributeNames: []string{"http", "https"},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/path",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator",
+					},
+				},
+				SchemaDefinition: "objectvalidator.ExactlyOneOf(\npath.MatchRelative().AtParent().AtName(\"http\"),\npath.MatchRelative().AtParent().AtName(\"https\"),\n)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.ObjectValidatorExactlyOneOf(testCase.attributeNames)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// AlsoRequiresFunction is the name of the validation function that
+	// requires other attributes to be configured with the validated attribute.
+	AlsoRequiresFunction = "AlsoRequires"
+
+	// AtLeastOneOfFunction is the name of the validation function that
+	// requires at least one of the validated attribute and other attributes
+	// to be configured.
+	AtLeastOneOfFunction = "AtLeastOneOf"
+
+	// ConflictsWithFunction is the name of the validation function that
+	// prevents other attributes from being configured with the validated
+	// attribute.
+	ConflictsWithFunction = "ConflictsWith"
+
+	// ExactlyOneOfFunction is the name of the validation function that
+	// requires exactly one of the validated attribute and other attributes
+	// to be configured.
+	ExactlyOneOfFunction = "ExactlyOneOf"
+)
+
+// PathExpressionsValidator returns a custom validator mapped to a function,
+// that accepts path expressions, of the given validation package, i.e.
+// stringvalidator.ConflictsWith. Each attribute name is written as a path
+// expression relative to the parent of the validated attribute, i.e. a
+// sibling attribute. If the attribute names are nil or empty, nil is
+// returned.
+func PathExpressionsValidator(packageName, functionName string, attributeNames []string) *schema.CustomValidator {
+	if len(attributeNames) == 0 {
+		return nil
+	}
+
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(packageName)
+	schemaDefinition.WriteString(".")
+	schemaDefinition.WriteString(functionName)
+	schemaDefinition.WriteString("(\n")
+
+	for _, attributeName := range attributeNames {
+		schemaDefinition.WriteString(PathPackage)
+		schemaDefinition.WriteString(".MatchRelative().AtParent().AtName(")
+		schemaDefinition.WriteString(strconv.Quote(attributeName))
+		schemaDefinition.WriteString("),\n")
+	}
+
+	schemaDefinition.WriteString(")")
+
+	return &schema.CustomValidator{
+		Imports: []code.Import{
+			PathCodeImport,
+			CodeImport(packageName),
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
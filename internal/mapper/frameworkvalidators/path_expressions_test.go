@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators_test
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPathExpressionsValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		packageName    string
+		functionName   string
+		attributeNames []string
+		expected       *schema.CustomValidator
+	}{
+		"nil": {
+			packageName:    frameworkvalidators.StringValidatorPackage,
+			functionName:   frameworkvalidators.ConflictsWithFunction,
+			attributeNames: nil,
+			expected:       nil,
+		},
+		"empty": {
+			packageName:    frameworkvalidators.StringValidatorPackage,
+			functionName:   frameworkvalidators.ConflictsWithFunction,
+			attributeNames: []string{},
+			expected:       nil,
+		},
+		"one": {
+			packageName:    frameworkvalidators.StringValidatorPackage,
+			functionName:   frameworkvalidators.ConflictsWithFunction,
+			attributeNames: []string{"vpc_no"},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/path",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+					},
+				},
+				SchemaDefinition: "stringvalidator.ConflictsWith(\npath.MatchRelative().AtParent().AtName(\"vpc_no\"),\n)",
+			},
+		},
+		"multiple": {
+			packageName:    frameworkvalidators.Int64ValidatorPackage,
+			functionName:   frameworkvalidators.AlsoRequiresFunction,
+			attributeNames: []string{"port", "protocol"},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/path",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+					},
+				},
+				SchemaDefinition: "int64validator.AlsoRequires(\npath.MatchRelative().AtParent().AtName(\"port\"),\npath.MatchRelative().AtParent().AtName(\"protocol\"),\n)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.PathExpressionsValidator(testCase.packageName, testCase.functionName, testCase.attributeNames)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
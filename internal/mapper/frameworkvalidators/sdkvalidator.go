@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators
+
+import (
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// MultipleOfFunction is the name of the SDK validation function that
+	// requires an int32, int64, float64, or number value to be a multiple of
+	// a value.
+	MultipleOfFunction = "MultipleOf"
+
+	// NumberAtLeastFunction, NumberAtMostFunction, NumberGreaterThanFunction,
+	// and NumberLessThanFunction are the names of the SDK validation
+	// functions for the inclusive and exclusive bounds of a number value.
+	NumberAtLeastFunction     = "NumberAtLeast"
+	NumberAtMostFunction      = "NumberAtMost"
+	NumberGreaterThanFunction = "NumberGreaterThan"
+	NumberLessThanFunction    = "NumberLessThan"
+)
+
+// SDKValidator returns a custom validator mapped to a validation function of
+// the generated SDK package, i.e. ncloudsdk.MultipleOf(5), for validations
+// that the framework validators module has no equivalent for. The package is
+// imported with an alias if the package name isn't the last element of the
+// import path. If the import path is empty, nil is returned, as the
+// validator can't be referenced.
+func SDKValidator(importPath, packageName, functionName string, value float64) *schema.CustomValidator {
+	if importPath == "" {
+		return nil
+	}
+
+	if packageName == "" {
+		packageName = path.Base(importPath)
+	}
+
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(packageName)
+	schemaDefinition.WriteString(".")
+	schemaDefinition.WriteString(functionName)
+	schemaDefinition.WriteString("(")
+	schemaDefinition.WriteString(strconv.FormatFloat(value, 'f', -1, 64))
+	schemaDefinition.WriteString(")")
+
+	codeImport := code.Import{
+		Path: importPath,
+	}
+
+	if packageName != path.Base(importPath) {
+		codeImport.Alias = &packageName
+	}
+
+	return &schema.CustomValidator{
+		Imports: []code.Import{
+			codeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
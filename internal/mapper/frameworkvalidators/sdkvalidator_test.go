@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators_test
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSDKValidator(t *testing.T) {
+	t.Parallel()
+
+	alias := "ncloudsdk"
+
+	testCases := map[string]struct {
+		importPath   string
+		packageName  string
+		functionName string
+		value        float64
+		expected     *schema.CustomValidator
+	}{
+		"no-import-path": {
+			packageName:  "ncloudsdk",
+			functionName: frameworkvalidators.MultipleOfFunction,
+			value:        5,
+			expected:     nil,
+		},
+		"package-name-from-import-path": {
+			importPath:   "github.com/example/terraform-provider-example/internal/ncloudsdk",
+			functionName: frameworkvalidators.MultipleOfFunction,
+			value:        5,
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/example/terraform-provider-example/internal/ncloudsdk",
+					},
+				},
+				SchemaDefinition: "ncloudsdk.MultipleOf(5)",
+			},
+		},
+		"package-name-matches-import-path": {
+			importPath:   "github.com/example/terraform-provider-example/internal/ncloudsdk",
+			packageName:  "ncloudsdk",
+			functionName: frameworkvalidators.NumberAtLeastFunction,
+			value:        0.5,
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/example/terraform-provider-example/internal/ncloudsdk",
+					},
+				},
+				SchemaDefinition: "ncloudsdk.NumberAtLeast(0.5)",
+			},
+		},
+		"package-name-alias": {
+			importPath:   "github.com/example/terraform-provider-example/internal/sdk",
+			packageName:  "ncloudsdk",
+			functionName: frameworkvalidators.NumberLessThanFunction,
+			value:        -1e21,
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Alias: &alias,
+						Path:  "github.com/example/terraform-provider-example/internal/sdk",
+					},
+				},
+				SchemaDefinition: "ncloudsdk.NumberLessThan(-1000000000000000000000)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.SDKValidator(testCase.importPath, testCase.packageName, testCase.functionName, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// mergeAllOfSchemas will deep merge every allOf subschema, along with the properties and keywords of the parent schema, into a single schema.
+//   - Properties that exist in multiple subschemas are recursively merged
+//   - The required lists of all subschemas are combined, as are their dependentRequired keywords
+//   - Required-only oneOf, anyOf and not subschemas are kept, as they are mapped to cross-attribute validators
+//   - The parent description takes precedence, otherwise the first populated description is used
+//   - For validation keywords, the most restrictive value is used (i.e. the largest minimum and the smallest maximum)
+//
+// Subschemas with no type are merged with any type, otherwise a type mismatch will return a SchemaError
+func mergeAllOfSchemas(s *base.Schema) (*base.Schema, *SchemaError) {
+	var merged *base.Schema
+
+	for _, allOfProxy := range s.AllOf {
+		allOfSchema, err := buildSchemaProxy(allOfProxy)
+		if err != nil {
+			return nil, err
+		}
+
+		if merged == nil {
+			// The first subschema is copied, which preserves the low-level model for line numbers in errors
+			merged = copySchema(allOfSchema)
+			continue
+		}
+
+		err = mergeSchema(merged, allOfSchema)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	parentSchema := *s
+	parentSchema.AllOf = nil
+
+	err := mergeSchema(merged, &parentSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	// Override the description w/ the parent if populated
+	if s.Description != "" {
+		merged.Description = s.Description
+	}
+
+	return merged, nil
+}
+
+// copySchema returns a shallow copy of a schema, with new properties, required and extensions collections that can be safely modified.
+func copySchema(s *base.Schema) *base.Schema {
+	schemaCopy := *s
+	schemaCopy.Required = slices.Clone(s.Required)
+
+	if s.Extensions != nil {
+		schemaCopy.Extensions = orderedmap.New[string, *yaml.Node]()
+		for pair := range orderedmap.Iterate(context.TODO(), s.Extensions) {
+			schemaCopy.Extensions.Set(pair.Key(), pair.Value())
+		}
+	}
+
+	if s.Properties != nil {
+		schemaCopy.Properties = orderedmap.New[string, *base.SchemaProxy]()
+		for pair := range orderedmap.Iterate(context.TODO(), s.Properties) {
+			schemaCopy.Properties.Set(pair.Key(), pair.Value())
+		}
+	}
+
+	return &schemaCopy
+}
+
+// mergeSchema will merge the source schema into the destination schema.
+func mergeSchema(dst *base.Schema, src *base.Schema) *SchemaError {
+	if len(dst.Type) == 0 {
+		dst.Type = src.Type
+	} else if len(src.Type) > 0 {
+		dstType, err := retrieveType(dst)
+		if err != nil {
+			return err
+		}
+
+		srcType, err := retrieveType(src)
+		if err != nil {
+			return err
+		}
+
+		if dstType != srcType {
+			return SchemaErrorFromNode(fmt.Errorf("[%s %s] - conflicting allOf subschema types, attribute cannot be created", dstType, srcType), src, Type)
+		}
+	}
+
+	if dst.Format == "" {
+		dst.Format = src.Format
+	}
+	if dst.Description == "" {
+		dst.Description = src.Description
+	}
+	if dst.Pattern == "" {
+		dst.Pattern = src.Pattern
+	}
+	if len(dst.Enum) == 0 {
+		dst.Enum = src.Enum
+	}
+	if dst.Default == nil {
+		dst.Default = src.Default
+	}
+	if dst.Items == nil {
+		dst.Items = src.Items
+	}
+	if dst.AdditionalProperties == nil {
+		dst.AdditionalProperties = src.AdditionalProperties
+	}
+	if dst.MultipleOf == nil {
+		dst.MultipleOf = src.MultipleOf
+	}
+	if dst.ExclusiveMinimum == nil {
+		dst.ExclusiveMinimum = src.ExclusiveMinimum
+	}
+	if dst.ExclusiveMaximum == nil {
+		dst.ExclusiveMaximum = src.ExclusiveMaximum
+	}
+	if dst.Nullable == nil {
+		dst.Nullable = src.Nullable
+	}
+
+	dst.Minimum = mergeMinimum(dst.Minimum, src.Minimum)
+	dst.Maximum = mergeMaximum(dst.Maximum, src.Maximum)
+	dst.MinLength = mergeMinimum(dst.MinLength, src.MinLength)
+	dst.MaxLength = mergeMaximum(dst.MaxLength, src.MaxLength)
+	dst.MinItems = mergeMinimum(dst.MinItems, src.MinItems)
+	dst.MaxItems = mergeMaximum(dst.MaxItems, src.MaxItems)
+	dst.MinProperties = mergeMinimum(dst.MinProperties, src.MinProperties)
+	dst.MaxProperties = mergeMaximum(dst.MaxProperties, src.MaxProperties)
+
+	dst.UniqueItems = mergeBool(dst.UniqueItems, src.UniqueItems)
+	dst.ReadOnly = mergeBool(dst.ReadOnly, src.ReadOnly)
+	dst.WriteOnly = mergeBool(dst.WriteOnly, src.WriteOnly)
+	dst.Deprecated = mergeBool(dst.Deprecated, src.Deprecated)
+
+	for _, name := range src.Required {
+		if !slices.Contains(dst.Required, name) {
+			dst.Required = append(dst.Required, name)
+		}
+	}
+
+	// Cross-attribute keywords of any subschema apply to the merged schema, see (OASSchema).GetCrossAttributeValidators
+	if len(dst.OneOf) == 0 && isRequiredOnlyComposition(src.OneOf) {
+		dst.OneOf = src.OneOf
+	}
+	if len(dst.AnyOf) == 0 && isRequiredOnlyComposition(src.AnyOf) {
+		dst.AnyOf = src.AnyOf
+	}
+	if dst.Not == nil && src.Not != nil && isRequiredOnlySchema(src.Not.Schema()) {
+		dst.Not = src.Not
+	}
+
+	err := mergeDependentRequired(dst, src)
+	if err != nil {
+		return err
+	}
+
+	if src.Properties == nil {
+		return nil
+	}
+
+	if dst.Properties == nil {
+		dst.Properties = orderedmap.New[string, *base.SchemaProxy]()
+	}
+
+	for pair := range orderedmap.Iterate(context.TODO(), src.Properties) {
+		name := pair.Key()
+
+		dstProxy, ok := dst.Properties.Get(name)
+		if !ok {
+			dst.Properties.Set(name, pair.Value())
+			continue
+		}
+
+		dstPropSchema, err := buildSchemaProxy(dstProxy)
+		if err != nil {
+			return err.NestedSchemaError(name, 0)
+		}
+
+		srcPropSchema, err := buildSchemaProxy(pair.Value())
+		if err != nil {
+			return err.NestedSchemaError(name, 0)
+		}
+
+		mergedPropSchema := copySchema(dstPropSchema)
+		err = mergeSchema(mergedPropSchema, srcPropSchema)
+		if err != nil {
+			return err.NestedSchemaError(name, 0)
+		}
+
+		dst.Properties.Set(name, base.CreateSchemaProxy(mergedPropSchema))
+	}
+
+	return nil
+}
+
+// mergeDependentRequired combines the dependentRequired keywords of both schemas into the extensions of the destination schema, as it's
+// only available in the low-level model, which the destination schema shares with the first allOf subschema. See dependentRequired.
+func mergeDependentRequired(dst *base.Schema, src *base.Schema) *SchemaError {
+	srcDependentRequired := dependentRequired(src)
+	if len(srcDependentRequired) == 0 {
+		return nil
+	}
+
+	merged := map[string][]string{}
+	for name, dependentNames := range dependentRequired(dst) {
+		merged[name] = slices.Clone(dependentNames)
+	}
+
+	for name, dependentNames := range srcDependentRequired {
+		for _, dependentName := range dependentNames {
+			if !slices.Contains(merged[name], dependentName) {
+				merged[name] = append(merged[name], dependentName)
+			}
+		}
+	}
+
+	node := &yaml.Node{}
+	if err := node.Encode(merged); err != nil {
+		return SchemaErrorFromNode(fmt.Errorf("failed to merge dependentRequired - %w", err), src, None)
+	}
+
+	if dst.Extensions == nil {
+		dst.Extensions = orderedmap.New[string, *yaml.Node]()
+	}
+	dst.Extensions.Set(util.OAS_keyword_dependent_required, node)
+
+	return nil
+}
+
+// mergeMinimum returns the largest of two lower bounds, which is the most restrictive.
+func mergeMinimum[T int64 | float64](a *T, b *T) *T {
+	if a == nil {
+		return b
+	}
+	if b == nil || *a >= *b {
+		return a
+	}
+
+	return b
+}
+
+// mergeMaximum returns the smallest of two upper bounds, which is the most restrictive.
+func mergeMaximum[T int64 | float64](a *T, b *T) *T {
+	if a == nil {
+		return b
+	}
+	if b == nil || *a <= *b {
+		return a
+	}
+
+	return b
+}
+
+// mergeBool returns true if either value is true.
+func mergeBool(a *bool, b *bool) *bool {
+	if a == nil {
+		return b
+	}
+	if b != nil && *b {
+		return b
+	}
+
+	return a
+}
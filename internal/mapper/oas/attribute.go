@@ -26,7 +26,9 @@ func (s *OASSchema) BuildResourceAttributes() (attrmapper.ResourceAttributes, *S
 
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
-			Ignores: s.GetIgnoresForNested(name),
+			Ignores:                  s.GetIgnoresForNested(name),
+			VariantSiblings:          s.GetVariantSiblings(name),
+			CrossAttributeValidators: s.GetCrossAttributeValidators(name),
 		}
 
 		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
@@ -34,7 +36,13 @@ func (s *OASSchema) BuildResourceAttributes() (attrmapper.ResourceAttributes, *S
 			return nil, s.NestSchemaError(err, name)
 		}
 
-		attribute, err := pSchema.BuildResourceAttribute(name, s.GetComputability(name))
+		computability := s.GetComputability(name)
+		if pSchema.IsReadOnly() {
+			// Read-only properties can't be set in a request, so they are always computed
+			computability = schema.Computed
+		}
+
+		attribute, err := pSchema.BuildResourceAttribute(name, computability)
 		if err != nil {
 			return nil, err
 		}
@@ -54,10 +62,12 @@ func (s *OASSchema) BuildResourceAttribute(name string, computability schema.Com
 	case util.OAS_type_string:
 		return s.BuildStringResource(name, computability)
 	case util.OAS_type_integer:
-		switch s.Format {
+		switch s.GetIntegerFormat() {
 		case util.OAS_format_int32:
 			return s.BuildInt32Resource(name, computability)
-		case util.OAS_format_int64:
+		case util.OAS_type_number:
+			return s.BuildNumberResource(name, computability)
+		default:
 			return s.BuildInt64Resource(name, computability)
 		}
 	case util.OAS_type_number:
@@ -74,8 +84,6 @@ func (s *OASSchema) BuildResourceAttribute(name string, computability schema.Com
 	default:
 		return nil, s.SchemaErrorFromProperty(fmt.Errorf("invalid schema type '%s'", s.Type), name)
 	}
-
-	return nil, nil
 }
 
 func (s *OASSchema) BuildDataSourceAttributes() (attrmapper.DataSourceAttributes, *SchemaError) {
@@ -91,7 +99,9 @@ func (s *OASSchema) BuildDataSourceAttributes() (attrmapper.DataSourceAttributes
 
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
-			Ignores: s.GetIgnoresForNested(name),
+			Ignores:                  s.GetIgnoresForNested(name),
+			VariantSiblings:          s.GetVariantSiblings(name),
+			CrossAttributeValidators: s.GetCrossAttributeValidators(name),
 		}
 
 		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
@@ -99,7 +109,13 @@ func (s *OASSchema) BuildDataSourceAttributes() (attrmapper.DataSourceAttributes
 			return nil, s.NestSchemaError(err, name)
 		}
 
-		attribute, err := pSchema.BuildDataSourceAttribute(name, s.GetComputability(name))
+		computability := s.GetComputability(name)
+		if pSchema.IsReadOnly() {
+			// Read-only properties can't be set in a request, so they are always computed
+			computability = schema.Computed
+		}
+
+		attribute, err := pSchema.BuildDataSourceAttribute(name, computability)
 		if err != nil {
 			return nil, err
 		}
@@ -119,11 +135,12 @@ func (s *OASSchema) BuildDataSourceAttribute(name string, computability schema.C
 	case util.OAS_type_string:
 		return s.BuildStringDataSource(name, computability)
 	case util.OAS_type_integer:
-		switch s.Format {
+		switch s.GetIntegerFormat() {
 		case util.OAS_format_int32:
 			return s.BuildInt32DataSource(name, computability)
-
-		case util.OAS_format_int64:
+		case util.OAS_type_number:
+			return s.BuildNumberDataSource(name, computability)
+		default:
 			return s.BuildInt64DataSource(name, computability)
 		}
 	case util.OAS_type_number:
@@ -140,8 +157,6 @@ func (s *OASSchema) BuildDataSourceAttribute(name string, computability schema.C
 	default:
 		return nil, s.SchemaErrorFromProperty(fmt.Errorf("invalid schema type '%s'", s.Type), name)
 	}
-
-	return nil, nil
 }
 
 func (s *OASSchema) BuildProviderAttributes() (attrmapper.ProviderAttributes, *SchemaError) {
@@ -157,7 +172,9 @@ func (s *OASSchema) BuildProviderAttributes() (attrmapper.ProviderAttributes, *S
 
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
-			Ignores: s.GetIgnoresForNested(name),
+			Ignores:                  s.GetIgnoresForNested(name),
+			VariantSiblings:          s.GetVariantSiblings(name),
+			CrossAttributeValidators: s.GetCrossAttributeValidators(name),
 		}
 
 		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
@@ -165,6 +182,11 @@ func (s *OASSchema) BuildProviderAttributes() (attrmapper.ProviderAttributes, *S
 			return nil, s.NestSchemaError(err, name)
 		}
 
+		// Provider attributes can't be computed, so read-only properties are skipped
+		if pSchema.IsReadOnly() {
+			continue
+		}
+
 		attribute, err := pSchema.BuildProviderAttribute(name, s.GetOptionalOrRequired(name))
 		if err != nil {
 			return nil, err
@@ -185,10 +207,12 @@ func (s *OASSchema) BuildProviderAttribute(name string, optionalOrRequired schem
 	case util.OAS_type_string:
 		return s.BuildStringProvider(name, optionalOrRequired)
 	case util.OAS_type_integer:
-		switch s.Format {
+		switch s.GetIntegerFormat() {
 		case util.OAS_format_int32:
 			return s.BuildInt32Provider(name, optionalOrRequired)
-		case util.OAS_format_int64:
+		case util.OAS_type_number:
+			return s.BuildNumberProvider(name, optionalOrRequired)
+		default:
 			return s.BuildInt64Provider(name, optionalOrRequired)
 		}
 	case util.OAS_type_number:
@@ -205,6 +229,4 @@ func (s *OASSchema) BuildProviderAttribute(name string, optionalOrRequired schem
 	default:
 		return nil, s.SchemaErrorFromProperty(fmt.Errorf("invalid schema type '%s'", s.Type), name)
 	}
-
-	return nil, nil
 }
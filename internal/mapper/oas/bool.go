@@ -5,6 +5,7 @@ package oas
 
 import (
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/provider"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
@@ -18,6 +19,7 @@ func (s *OASSchema) BuildBoolResource(name string, computability schema.Computed
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -34,6 +36,10 @@ func (s *OASSchema) BuildBoolResource(name string, computability schema.Computed
 		}
 	}
 
+	if computability != schema.Computed {
+		result.Validators = s.GetBoolValidators()
+	}
+
 	return result, nil
 }
 
@@ -44,9 +50,14 @@ func (s *OASSchema) BuildBoolDataSource(name string, computability schema.Comput
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsDataSourceSensitive(),
 		},
 	}
 
+	if computability != schema.Computed {
+		result.Validators = s.GetBoolValidators()
+	}
+
 	return result, nil
 }
 
@@ -57,6 +68,8 @@ func (s *OASSchema) BuildBoolProvider(name string, optionalOrRequired schema.Opt
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
+			Validators:         s.GetBoolValidators(),
 		},
 	}, nil
 }
@@ -66,3 +79,15 @@ func (s *OASSchema) BuildBoolElementType() (schema.ElementType, *SchemaError) {
 		Bool: &schema.BoolType{},
 	}, nil
 }
+
+func (s *OASSchema) GetBoolValidators() []schema.BoolValidator {
+	var result []schema.BoolValidator
+
+	for _, validator := range s.getCrossAttributeValidators(frameworkvalidators.BoolValidatorPackage) {
+		result = append(result, schema.BoolValidator{
+			Custom: validator,
+		})
+	}
+
+	return result
+}
@@ -33,18 +33,47 @@ func BuildSchemaFromRequest(op *high.Operation, schemaOpts SchemaOpts, globalOpt
 //   - Response codes of 200 and then 201 will be prioritized, then will continue to the next available 2xx code
 //   - Media type will default to "application/json", then continue to the next available media type with a schema
 func BuildSchemaFromResponse(op *high.Operation, schemaOpts SchemaOpts, globalOpts GlobalSchemaOpts) (*OASSchema, error) {
+	return BuildSchemaFromResponsePath(op, "", schemaOpts, globalOpts)
+}
+
+// BuildSchemaFromResponsePath will extract the schema from the response body of an operation (see BuildSchemaFromResponse), then descend
+// to the subschema referenced by a [JSON Pointer] before building, i.e. `/getServerInstanceListResponse/serverInstanceList/0`
+//   - Object properties are referenced by name, array items are referenced by any index
+//   - An empty response path will build the entire response body schema
+//
+// [JSON Pointer]: https://datatracker.ietf.org/doc/html/rfc6901
+func BuildSchemaFromResponsePath(op *high.Operation, responsePath string, schemaOpts SchemaOpts, globalOpts GlobalSchemaOpts) (*OASSchema, error) {
+	proxy, err := getResponseSchemaProxy(op)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy, schemaErr := getSchemaProxyAtPath(proxy, responsePath)
+	if schemaErr != nil {
+		return nil, schemaErr
+	}
+
+	s, schemaErr := BuildSchema(proxy, schemaOpts, globalOpts)
+	if schemaErr != nil {
+		return nil, schemaErr
+	}
+
+	return s, nil
+}
+
+func getResponseSchemaProxy(op *high.Operation) (*base.SchemaProxy, error) {
 	if op == nil || op.Responses == nil || op.Responses.Codes == nil || op.Responses.Codes.Len() == 0 {
 		return nil, ErrSchemaNotFound
 	}
 
 	okResponse, ok := op.Responses.Codes.Get(util.OAS_response_code_ok)
 	if ok {
-		return getSchemaFromMediaType(okResponse.Content, schemaOpts, globalOpts)
+		return getSchemaProxyFromMediaType(okResponse.Content)
 	}
 
 	createdResponse, ok := op.Responses.Codes.Get(util.OAS_response_code_created)
 	if ok {
-		return getSchemaFromMediaType(createdResponse.Content, schemaOpts, globalOpts)
+		return getSchemaProxyFromMediaType(createdResponse.Content)
 	}
 
 	sortedCodes := orderedmap.SortAlpha(op.Responses.Codes)
@@ -56,36 +85,146 @@ func BuildSchemaFromResponse(op *high.Operation, schemaOpts SchemaOpts, globalOp
 		}
 
 		if statusCode >= 200 && statusCode <= 299 {
-			return getSchemaFromMediaType(responseCode.Content, schemaOpts, globalOpts)
+			return getSchemaProxyFromMediaType(responseCode.Content)
 		}
 	}
 
 	return nil, ErrSchemaNotFound
 }
 
+// SchemaProxyAtPath descends to the subschema referenced by a response path the same way as the attribute mapping, see getSchemaProxyAtPath.
+// It's used by code generated for the response of the same operations as the provider code spec, i.e. the Ncloud SDK layer.
+func SchemaProxyAtPath(proxy *base.SchemaProxy, responsePath string) (*base.SchemaProxy, *SchemaError) {
+	return getSchemaProxyAtPath(proxy, responsePath)
+}
+
+// getSchemaProxyAtPath will descend from a schema proxy to the subschema referenced by a JSON pointer.
+func getSchemaProxyAtPath(proxy *base.SchemaProxy, responsePath string) (*base.SchemaProxy, *SchemaError) {
+	for _, token := range util.JSONPointerTokens(responsePath) {
+		s, err := buildSchemaProxy(proxy)
+		if err != nil {
+			return nil, err
+		}
+
+		oasType, err := retrieveType(s)
+		if err != nil {
+			return nil, err
+		}
+
+		switch oasType {
+		case util.OAS_type_object:
+			if s.Properties == nil {
+				return nil, SchemaErrorFromProxy(fmt.Errorf("response path '%s' - property '%s' not found", responsePath, token), proxy)
+			}
+
+			propProxy, ok := s.Properties.Get(token)
+			if !ok {
+				return nil, SchemaErrorFromProxy(fmt.Errorf("response path '%s' - property '%s' not found", responsePath, token), proxy)
+			}
+
+			proxy = propProxy
+		case util.OAS_type_array:
+			if _, err := strconv.Atoi(token); err != nil {
+				return nil, SchemaErrorFromProxy(fmt.Errorf("response path '%s' - '%s' is not a valid array index", responsePath, token), proxy)
+			}
+
+			if s.Items == nil || !s.Items.IsA() {
+				return nil, SchemaErrorFromNode(fmt.Errorf("response path '%s' - array items not found", responsePath), s, Items)
+			}
+
+			proxy = s.Items.A
+		default:
+			return nil, SchemaErrorFromProxy(fmt.Errorf("response path '%s' - cannot descend into '%s' type at '%s'", responsePath, oasType, token), proxy)
+		}
+	}
+
+	return proxy, nil
+}
+
+// BuildSchemaFromParameters will build an object schema from the `query` and `path` parameters of an operation
+//   - Each parameter is mapped to a property, using the alias as the property name if one exists
+//   - Required parameters are added to the `required` list of the object schema
+//   - The parameter description takes precedence over the description of the parameter schema
+func BuildSchemaFromParameters(params []*high.Parameter, aliases map[string]string, schemaOpts SchemaOpts, globalOpts GlobalSchemaOpts) (*OASSchema, error) {
+	properties := orderedmap.New[string, *base.SchemaProxy]()
+	required := []string{}
+
+	for _, param := range params {
+		if param.In != util.OAS_param_path && param.In != util.OAS_param_query {
+			continue
+		}
+
+		if param.Schema == nil {
+			continue
+		}
+
+		paramName := param.Name
+		if aliasedName, ok := aliases[param.Name]; ok {
+			paramName = aliasedName
+		}
+
+		paramProxy := param.Schema
+		if param.Description != "" {
+			// Wrapping with a single allOf will override the description of the parameter schema
+			paramProxy = base.CreateSchemaProxy(&base.Schema{
+				Description: param.Description,
+				AllOf:       []*base.SchemaProxy{param.Schema},
+			})
+		}
+
+		properties.Set(paramName, paramProxy)
+		if param.Required != nil && *param.Required {
+			required = append(required, paramName)
+		}
+	}
+
+	if properties.Len() == 0 {
+		return nil, ErrSchemaNotFound
+	}
+
+	parametersProxy := base.CreateSchemaProxy(&base.Schema{
+		Type:       []string{util.OAS_type_object},
+		Properties: properties,
+		Required:   required,
+	})
+
+	s, err := BuildSchema(parametersProxy, schemaOpts, globalOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
 func getSchemaFromMediaType(mediaTypes *orderedmap.Map[string, *high.MediaType], schemaOpts SchemaOpts, globalOpts GlobalSchemaOpts) (*OASSchema, error) {
+	proxy, err := getSchemaProxyFromMediaType(mediaTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	s, schemaErr := BuildSchema(proxy, schemaOpts, globalOpts)
+	if schemaErr != nil {
+		return nil, schemaErr
+	}
+
+	return s, nil
+}
+
+func getSchemaProxyFromMediaType(mediaTypes *orderedmap.Map[string, *high.MediaType]) (*base.SchemaProxy, error) {
 	if mediaTypes == nil {
 		return nil, ErrSchemaNotFound
 	}
 
 	jsonMediaType, ok := mediaTypes.Get(util.OAS_mediatype_json)
 	if ok && jsonMediaType.Schema != nil {
-		s, err := BuildSchema(jsonMediaType.Schema, schemaOpts, globalOpts)
-		if err != nil {
-			return nil, err
-		}
-		return s, nil
+		return jsonMediaType.Schema, nil
 	}
 
 	sortedMediaTypes := orderedmap.SortAlpha(mediaTypes)
 	for pair := range orderedmap.Iterate(context.TODO(), sortedMediaTypes) {
 		mediaType := pair.Value()
 		if mediaType.Schema != nil {
-			s, err := BuildSchema(mediaType.Schema, schemaOpts, globalOpts)
-			if err != nil {
-				return nil, err
-			}
-			return s, nil
+			return mediaType.Schema, nil
 		}
 	}
 
@@ -117,10 +256,19 @@ func BuildSchema(proxy *base.SchemaProxy, schemaOpts SchemaOpts, globalOpts Glob
 	return &resp, nil
 }
 
+// ResolveSchemaProxy builds a schema proxy, resolving schema composition the same way as the attribute mapping, see buildSchemaProxy for details.
+// It's used by code generated from the same schemas as the provider code spec, i.e. the Ncloud SDK layer, so both have the same shape.
+func ResolveSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
+	return buildSchemaProxy(proxy)
+}
+
 // buildSchemaProxy is a helper function that builds a schema proxy. If needed, it will recursively resolve a specific set of [schema composition] keywords:
-//   - allOf: If len == 1, will resolve with that one item.
+//   - allOf: Will resolve by deep merging all items, see mergeAllOfSchemas for details.
 //   - anyOf: If len == 2, will resolve nullable or stringable types
 //   - oneOf: If len == 2, will resolve nullable or stringable types
+//   - anyOf/oneOf with a discriminator: Will resolve to an object with each subschema as a property, see buildDiscriminatedSchema for details.
+//   - anyOf/oneOf with required-only subschemas: Will be ignored, as they are mapped to cross-attribute validators. Returns a SchemaError
+//     unless each subschema requires a single property, which is the only combination that can be mapped.
 //
 // # Any other combinations of allOf, anyOf, or oneOf will return a SchemaError
 //
@@ -131,14 +279,42 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 		return nil, SchemaErrorFromProxy(fmt.Errorf("failed to build schema proxy - %w", err), proxy)
 	}
 
+	// Subschemas that only list required properties don't change the shape of the schema, they are mapped to cross-attribute
+	// validators instead, see (OASSchema).GetCrossAttributeValidators for details. Only subschemas that each require a single
+	// property can be mapped, ignoring any other combination would silently drop the constraint.
+	anyOf, oneOf := s.AnyOf, s.OneOf
+	if isRequiredOnlyComposition(anyOf) {
+		if requiredOnlyPropertyNames(anyOf) == nil {
+			return nil, SchemaErrorFromNode(fmt.Errorf("found %d required-only anyOf subschema(s), only subschemas that each require a single property are supported", len(anyOf)), s, AnyOf)
+		}
+		anyOf = nil
+	}
+	if isRequiredOnlyComposition(oneOf) {
+		if requiredOnlyPropertyNames(oneOf) == nil {
+			return nil, SchemaErrorFromNode(fmt.Errorf("found %d required-only oneOf subschema(s), only subschemas that each require a single property are supported", len(oneOf)), s, OneOf)
+		}
+		oneOf = nil
+	}
+
 	// If there are no schema composition keywords, return the schema
-	if len(s.AllOf) == 0 && len(s.AnyOf) == 0 && len(s.OneOf) == 0 {
+	if len(s.AllOf) == 0 && len(anyOf) == 0 && len(oneOf) == 0 {
 		return s, nil
 	}
 
-	if len(s.AnyOf) > 0 {
-		if len(s.AnyOf) == 2 {
-			schema, err := getMultiTypeSchema(s.AnyOf[0], s.AnyOf[1])
+	// If there is a discriminator, each oneOf/anyOf subschema is a variant of the parent schema
+	if s.Discriminator != nil {
+		if len(oneOf) > 0 {
+			return buildDiscriminatedSchema(s, oneOf, OneOf)
+		}
+
+		if len(anyOf) > 0 {
+			return buildDiscriminatedSchema(s, anyOf, AnyOf)
+		}
+	}
+
+	if len(anyOf) > 0 {
+		if len(anyOf) == 2 {
+			schema, err := getMultiTypeSchema(anyOf[0], anyOf[1])
 			if err != nil {
 				return nil, err
 			}
@@ -147,12 +323,12 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 		}
 
 		// Dynamic type currently not supported
-		return nil, SchemaErrorFromNode(fmt.Errorf("found %d anyOf subschema(s), schema composition is currently not supported", len(s.AnyOf)), s, AnyOf)
+		return nil, SchemaErrorFromNode(fmt.Errorf("found %d anyOf subschema(s), schema composition is currently not supported", len(anyOf)), s, AnyOf)
 	}
 
-	if len(s.OneOf) > 0 {
-		if len(s.OneOf) == 2 {
-			schema, err := getMultiTypeSchema(s.OneOf[0], s.OneOf[1])
+	if len(oneOf) > 0 {
+		if len(oneOf) == 2 {
+			schema, err := getMultiTypeSchema(oneOf[0], oneOf[1])
 			if err != nil {
 				return nil, err
 			}
@@ -161,27 +337,11 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 		}
 
 		// Dynamic type currently not supported
-		return nil, SchemaErrorFromNode(fmt.Errorf("found %d oneOf subschema(s), schema composition is currently not supported", len(s.OneOf)), s, OneOf)
-	}
-
-	// If there is just one allOf, we can use it as the schema
-	if len(s.AllOf) == 1 {
-		allOfSchema, err := buildSchemaProxy(s.AllOf[0])
-		if err != nil {
-			return nil, err
-		}
-
-		// Override the description w/ the parent if populated
-		if s.Description != "" {
-			allOfSchema.Description = s.Description
-		}
-
-		return allOfSchema, nil
+		return nil, SchemaErrorFromNode(fmt.Errorf("found %d oneOf subschema(s), schema composition is currently not supported", len(oneOf)), s, OneOf)
 	}
 
-	// Combining multiple allOf schemas and their properties is possible here, but currently not supported
-	// See: https://github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/issues/56
-	return nil, SchemaErrorFromNode(fmt.Errorf("found %d allOf subschema(s), schema composition is currently not supported", len(s.AllOf)), s, AllOf)
+	// All allOf subschemas are merged with the parent schema
+	return mergeAllOfSchemas(s)
 }
 
 // getMultiTypeSchema will check the types of both schemas provided and will return the non-null schema. If a null schema type is not
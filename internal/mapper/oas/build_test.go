@@ -4,19 +4,24 @@
 package oas_test
 
 import (
+	"context"
+	"errors"
 	"regexp"
 	"testing"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/oas"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pb33f/libopenapi"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 func TestBuildSchemaFromRequest(t *testing.T) {
@@ -468,6 +473,240 @@ func TestBuildSchemaFromResponse_Errors(t *testing.T) {
 	}
 }
 
+func TestBuildSchemaFromResponsePath(t *testing.T) {
+	t.Parallel()
+
+	envelopeOp := &high.Operation{
+		Responses: &high.Responses{
+			Codes: orderedmap.ToOrderedMap(map[string]*high.Response{
+				"200": {
+					Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
+						"application/json": {
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"object"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"getThingListResponse": base.CreateSchemaProxy(&base.Schema{
+										AllOf: []*base.SchemaProxy{
+											base.CreateSchemaProxy(&base.Schema{
+												Type: []string{"object"},
+												Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+													"thingList": base.CreateSchemaProxy(&base.Schema{
+														Type: []string{"array"},
+														Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+															A: base.CreateSchemaProxy(&base.Schema{
+																Description: "this is the correct one!",
+																Type:        []string{"object"},
+															}),
+														},
+													}),
+												}),
+											}),
+										},
+									}),
+								}),
+							}),
+						},
+					}),
+				},
+			}),
+		},
+	}
+
+	testCases := map[string]struct {
+		op               *high.Operation
+		responsePath     string
+		expectedSchema   *oas.OASSchema
+		expectedErrRegex string
+	}{
+		"descend through properties, allOf and array items": {
+			op:           envelopeOp,
+			responsePath: "/getThingListResponse/thingList/0",
+			expectedSchema: &oas.OASSchema{
+				Type: "object",
+				Schema: &base.Schema{
+					Description: "this is the correct one!",
+					Type:        []string{"object"},
+				},
+			},
+		},
+		"property not found": {
+			op:               envelopeOp,
+			responsePath:     "/getThingListResponse/otherList/0",
+			expectedErrRegex: `response path '/getThingListResponse/otherList/0' - property 'otherList' not found`,
+		},
+		"invalid array index": {
+			op:               envelopeOp,
+			responsePath:     "/getThingListResponse/thingList/first",
+			expectedErrRegex: `response path '/getThingListResponse/thingList/first' - 'first' is not a valid array index`,
+		},
+		"descend into object without properties": {
+			op:               envelopeOp,
+			responsePath:     "/getThingListResponse/thingList/0/id",
+			expectedErrRegex: `response path '/getThingListResponse/thingList/0/id' - property 'id' not found`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := oas.BuildSchemaFromResponsePath(testCase.op, testCase.responsePath, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+			if testCase.expectedErrRegex != "" {
+				if err == nil {
+					t.Fatalf("Expected err to match %q, got nil", testCase.expectedErrRegex)
+				}
+				if !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+					t.Errorf("Expected error to match %q, got %q", testCase.expectedErrRegex, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedSchema, cmpopts.IgnoreUnexported(base.Schema{}, oas.OASSchema{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSchemaFromParameters(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		params             []*high.Parameter
+		aliases            map[string]string
+		expectedAttributes attrmapper.ResourceAttributes
+	}{
+		"query and path parameters with required inference": {
+			params: []*high.Parameter{
+				{
+					Name:     "serverName",
+					In:       "query",
+					Required: pointer(true),
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"string"},
+						Description: "hey there! I'm a string type, required.",
+					}),
+				},
+				{
+					Name:        "zoneCode",
+					In:          "path",
+					Description: "hey there! I'm the parameter description.",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"string"},
+						Description: "you shouldn't see this because the description is overridden!",
+					}),
+				},
+				{
+					Name: "isProtected",
+					In:   "query",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+					}),
+				},
+				{
+					Name: "X-Request-Id",
+					In:   "header",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceBoolAttribute{
+					Name: "isProtected",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "serverName",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey there! I'm a string type, required."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "zoneCode",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm the parameter description."),
+					},
+				},
+			},
+		},
+		"aliased parameters": {
+			params: []*high.Parameter{
+				{
+					Name:     "serverName",
+					In:       "query",
+					Required: pointer(true),
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+			},
+			aliases: map[string]string{
+				"serverName": "name",
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := oas.BuildSchemaFromParameters(testCase.params, testCase.aliases, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			attributes, schemaErr := got.BuildResourceAttributes()
+			if schemaErr != nil {
+				t.Fatalf("unexpected error: %s", schemaErr)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSchemaFromParameters_Errors(t *testing.T) {
+	t.Parallel()
+
+	params := []*high.Parameter{
+		{
+			Name: "X-Request-Id",
+			In:   "header",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		},
+	}
+
+	_, err := oas.BuildSchemaFromParameters(params, nil, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+	if !errors.Is(err, oas.ErrSchemaNotFound) {
+		t.Errorf("expected error %q, got: %v", oas.ErrSchemaNotFound, err)
+	}
+}
+
 func TestBuildSchema_MultiTypes(t *testing.T) {
 	t.Parallel()
 
@@ -1067,6 +1306,120 @@ func TestBuildSchema_AllOfSchemaComposition(t *testing.T) {
 				},
 			},
 		},
+		"allOf with multiple elements - merge properties and required": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type:     []string{"object"},
+						Required: []string{"id"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"id": base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"string"},
+								Description: "hey there! I'm the base id.",
+							}),
+							"nested_object": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"object"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"bool": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"boolean"},
+									}),
+								}),
+							}),
+						}),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Required: []string{"name"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"name": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+							"nested_object": base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"object"},
+								Description: "hey there! I'm a merged object.",
+								Required:    []string{"string"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"string": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+						}),
+					}),
+				},
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "id",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey there! I'm the base id."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "nested_object",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceBoolAttribute{
+							Name: "bool",
+							BoolAttribute: resource.BoolAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+							},
+						},
+						&attrmapper.ResourceStringAttribute{
+							Name: "string",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Required,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm a merged object."),
+					},
+				},
+			},
+		},
+		"allOf with multiple elements - merge validation keywords": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"string_prop": base.CreateSchemaProxy(&base.Schema{
+						Description: "Override the string's description",
+						AllOf: []*base.SchemaProxy{
+							base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"string"},
+								Description: "hey there! I'm a string type.",
+								MinLength:   pointer(int64(1)),
+								MaxLength:   pointer(int64(255)),
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								MinLength: pointer(int64(5)),
+								MaxLength: pointer(int64(300)),
+							}),
+						},
+					}),
+				}),
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("Override the string's description"),
+						Validators: []schema.StringValidator{
+							{
+								Custom: frameworkvalidators.StringValidatorLengthBetween(5, 255),
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -1092,6 +1445,338 @@ func TestBuildSchema_AllOfSchemaComposition(t *testing.T) {
 	}
 }
 
+func TestBuildSchema_DiscriminatedSchemaComposition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schemaProxy        *base.SchemaProxy
+		globalSchemaOpts   oas.GlobalSchemaOpts
+		expectedAttributes attrmapper.ResourceAttributes
+	}{
+		"oneOf with discriminator - variant names from const and enum": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"listener": base.CreateSchemaProxy(&base.Schema{
+						Description: "hey there! I'm a listener.",
+						Discriminator: &base.Discriminator{
+							PropertyName: "protocol",
+						},
+						OneOf: []*base.SchemaProxy{
+							base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"object"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"protocol": base.CreateSchemaProxy(&base.Schema{
+										Type:  []string{"string"},
+										Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "http"},
+									}),
+								}),
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"object"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"certificate": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+									"protocol": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+										Enum: []*yaml.Node{
+											{Kind: yaml.ScalarNode, Value: "https"},
+										},
+									}),
+								}),
+							}),
+						},
+					}),
+				}),
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "listener",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "http",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "protocol",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								Validators: []schema.ObjectValidator{
+									{
+										Custom: frameworkvalidators.ObjectValidatorExactlyOneOf([]string{"https"}),
+									},
+								},
+							},
+						},
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "https",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "certificate",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+								&attrmapper.ResourceStringAttribute{
+									Name: "protocol",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+										Validators: []schema.StringValidator{
+											{
+												Custom: frameworkvalidators.StringValidatorOneOf([]string{"https"}),
+											},
+										},
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								Validators: []schema.ObjectValidator{
+									{
+										Custom: frameworkvalidators.ObjectValidatorExactlyOneOf([]string{"http"}),
+									},
+								},
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm a listener."),
+					},
+				},
+			},
+		},
+		"oneOf with discriminator - parent properties": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"name"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"name": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+				Discriminator: &base.Discriminator{
+					PropertyName: "type",
+				},
+				OneOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"type": base.CreateSchemaProxy(&base.Schema{
+								Type:  []string{"string"},
+								Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "cat"},
+							}),
+						}),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"type": base.CreateSchemaProxy(&base.Schema{
+								Type:  []string{"string"},
+								Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "dog"},
+							}),
+						}),
+					}),
+				},
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "cat",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{
+							Name: "type",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: []schema.ObjectValidator{
+							{
+								Custom: frameworkvalidators.ObjectValidatorExactlyOneOf([]string{"dog"}),
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "dog",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{
+							Name: "type",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: []schema.ObjectValidator{
+							{
+								Custom: frameworkvalidators.ObjectValidatorExactlyOneOf([]string{"cat"}),
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+		},
+		"anyOf with discriminator - computed variants have no validators": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Discriminator: &base.Discriminator{
+					PropertyName: "type",
+				},
+				AnyOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"type": base.CreateSchemaProxy(&base.Schema{
+								Type:  []string{"string"},
+								Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "Cat"},
+							}),
+						}),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"type": base.CreateSchemaProxy(&base.Schema{
+								Type:  []string{"string"},
+								Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "Dog"},
+							}),
+						}),
+					}),
+				},
+			}),
+			globalSchemaOpts: oas.GlobalSchemaOpts{
+				OverrideComputability: schema.Computed,
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "Cat",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{
+							Name: "type",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Computed,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "Dog",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{
+							Name: "type",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Computed,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, err := oas.BuildSchema(testCase.schemaProxy, oas.SchemaOpts{}, testCase.globalSchemaOpts)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			attributes, err := schema.BuildResourceAttributes()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSchema_DiscriminatedSchemaReferences(t *testing.T) {
+	t.Parallel()
+
+	testOAS := `
+openapi: 3.1.0
+info:
+  title: Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Listener:
+      oneOf:
+        - $ref: '#/components/schemas/HttpListener'
+        - $ref: '#/components/schemas/TcpListener'
+      discriminator:
+        propertyName: protocol
+        mapping:
+          http: '#/components/schemas/HttpListener'
+    HttpListener:
+      type: object
+      properties:
+        port:
+          type: string
+    TcpListener:
+      type: object
+      properties:
+        port:
+          type: string
+`
+
+	doc, err := libopenapi.NewDocument([]byte(testOAS))
+	if err != nil {
+		t.Fatalf("unexpected error parsing test OAS: %s", err)
+	}
+
+	model, errs := doc.BuildV3Model()
+	if len(errs) > 0 {
+		t.Fatalf("unexpected error building test OAS: %s", errors.Join(errs...))
+	}
+
+	listenerProxy, ok := model.Model.Components.Schemas.Get("Listener")
+	if !ok {
+		t.Fatal("expected Listener schema in test OAS")
+	}
+
+	schema, schemaErr := oas.BuildSchema(listenerProxy, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+	if schemaErr != nil {
+		t.Fatalf("unexpected error: %s", schemaErr)
+	}
+
+	got := []string{}
+	for pair := range orderedmap.Iterate(context.TODO(), schema.Schema.Properties) {
+		got = append(got, pair.Key())
+	}
+
+	// The mapping key is used for the first variant, the $ref name is used for the second variant
+	expected := []string{"http", "TcpListener"}
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
 func TestBuildSchema_Errors(t *testing.T) {
 	t.Parallel()
 
@@ -1137,18 +1822,107 @@ func TestBuildSchema_Errors(t *testing.T) {
 			}),
 			expectedErrRegex: `\[object string\] - unsupported multi-type, attribute cannot be created`,
 		},
-		"too many allOf": {
+		"unsupported required-only oneOf": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				OneOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Required: []string{"subnetNo", "vpcNo"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Required: []string{"networkInterfaceNo"},
+					}),
+				},
+			}),
+			expectedErrRegex: `found 2 required-only oneOf subschema\(s\), only subschemas that each require a single property are supported`,
+		},
+		"unsupported required-only anyOf": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				AnyOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Required: []string{"subnetNo"},
+					}),
+				},
+			}),
+			expectedErrRegex: `found 1 required-only anyOf subschema\(s\), only subschemas that each require a single property are supported`,
+		},
+		"conflicting allOf types": {
 			schemaProxy: base.CreateSchemaProxy(&base.Schema{
 				AllOf: []*base.SchemaProxy{
 					base.CreateSchemaProxy(&base.Schema{
-						Type: []string{"null"},
+						Type: []string{"integer"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+			}),
+			expectedErrRegex: `\[integer string\] - conflicting allOf subschema types, attribute cannot be created`,
+		},
+		"conflicting allOf nested property types": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"id": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"id": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"boolean"},
+							}),
+						}),
 					}),
+				},
+			}),
+			expectedErrRegex: `\[string boolean\] - conflicting allOf subschema types, attribute cannot be created`,
+		},
+		"discriminator variant without name": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Discriminator: &base.Discriminator{
+					PropertyName: "type",
+				},
+				OneOf: []*base.SchemaProxy{
 					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"type": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}),
+				},
+			}),
+			expectedErrRegex: `unable to determine a discriminator variant name`,
+		},
+		"discriminator variant conflicts with a parent property": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"cat": base.CreateSchemaProxy(&base.Schema{
 						Type: []string{"string"},
 					}),
+				}),
+				Discriminator: &base.Discriminator{
+					PropertyName: "type",
+				},
+				OneOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"type": base.CreateSchemaProxy(&base.Schema{
+								Type:  []string{"string"},
+								Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "cat"},
+							}),
+						}),
+					}),
 				},
 			}),
-			expectedErrRegex: `found 2 allOf subschema\(s\), schema composition is currently not supported`,
+			expectedErrRegex: `discriminator variant 'cat' conflicts with a property of the parent schema`,
 		},
 		"too many anyOf": {
 			schemaProxy: base.CreateSchemaProxy(&base.Schema{
@@ -45,6 +45,7 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 					ComputedOptionalRequired: computability,
 					DeprecationMessage:       s.GetDeprecationMessage(),
 					Description:              s.GetDescription(),
+					Sensitive:                s.IsSensitive(),
 				},
 			}
 
@@ -64,6 +65,7 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -87,6 +89,7 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -104,6 +107,7 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -145,6 +149,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 					ComputedOptionalRequired: computability,
 					DeprecationMessage:       s.GetDeprecationMessage(),
 					Description:              s.GetDescription(),
+					Sensitive:                s.IsDataSourceSensitive(),
 				},
 			}
 
@@ -164,6 +169,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsDataSourceSensitive(),
 			},
 		}
 
@@ -188,6 +194,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsDataSourceSensitive(),
 			},
 		}
 
@@ -205,6 +212,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsDataSourceSensitive(),
 		},
 	}
 
@@ -246,6 +254,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 					OptionalRequired:   optionalOrRequired,
 					DeprecationMessage: s.GetDeprecationMessage(),
 					Description:        s.GetDescription(),
+					Sensitive:          s.IsSensitive(),
 					Validators:         s.GetSetValidators(),
 				},
 			}
@@ -262,6 +271,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 				OptionalRequired:   optionalOrRequired,
 				DeprecationMessage: s.GetDeprecationMessage(),
 				Description:        s.GetDescription(),
+				Sensitive:          s.IsSensitive(),
 				Validators:         s.GetListValidators(),
 			},
 		}
@@ -282,6 +292,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 				OptionalRequired:   optionalOrRequired,
 				DeprecationMessage: s.GetDeprecationMessage(),
 				Description:        s.GetDescription(),
+				Sensitive:          s.IsSensitive(),
 				Validators:         s.GetSetValidators(),
 			},
 		}
@@ -296,6 +307,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
 			Validators:         s.GetListValidators(),
 		},
 	}
@@ -362,6 +374,12 @@ func (s *OASSchema) GetListValidators() []schema.ListValidator {
 		})
 	}
 
+	for _, validator := range s.getCrossAttributeValidators(frameworkvalidators.ListValidatorPackage) {
+		result = append(result, schema.ListValidator{
+			Custom: validator,
+		})
+	}
+
 	return result
 }
 
@@ -385,5 +403,11 @@ func (s *OASSchema) GetSetValidators() []schema.SetValidator {
 		})
 	}
 
+	for _, validator := range s.getCrossAttributeValidators(frameworkvalidators.SetValidatorPackage) {
+		result = append(result, schema.SetValidator{
+			Custom: validator,
+		})
+	}
+
 	return result
 }
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"slices"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"gopkg.in/yaml.v3"
+)
+
+// CrossAttributeValidator is a validator of an attribute that references sibling attributes, mapped from the keywords of the parent object schema.
+type CrossAttributeValidator struct {
+	// FunctionName is the name of the validation function, i.e. frameworkvalidators.ExactlyOneOfFunction
+	FunctionName string
+
+	// AttributeNames are the Terraform identifiers of the sibling attributes
+	AttributeNames []string
+}
+
+// GetCrossAttributeValidators returns the validators of a property that reference sibling properties, mapped from these keywords of the object schema:
+//   - oneOf: with required-only subschemas, i.e. `oneOf: [{required: [a]}, {required: [b]}]`, mapped to an "exactly one of" validator
+//   - anyOf: with required-only subschemas, i.e. `anyOf: [{required: [a]}, {required: [b]}]`, mapped to an "at least one of" validator
+//   - dependentRequired: i.e. `dependentRequired: {a: [b]}`, mapped to an "also requires" validator for `a`
+//   - not: with a required-only subschema of two properties, i.e. `not: {required: [a, b]}`, mapped to a "conflicts with" validator
+//
+// Returns nil if the property is computed, as it can't be configured.
+func (s *OASSchema) GetCrossAttributeValidators(name string) []CrossAttributeValidator {
+	if s.GetComputability(name) == schema.Computed {
+		return nil
+	}
+
+	var result []CrossAttributeValidator
+
+	if oneOfNames := requiredOnlyPropertyNames(s.Schema.OneOf); slices.Contains(oneOfNames, name) {
+		result = s.appendCrossAttributeValidator(result, frameworkvalidators.ExactlyOneOfFunction, name, oneOfNames)
+	}
+
+	if anyOfNames := requiredOnlyPropertyNames(s.Schema.AnyOf); slices.Contains(anyOfNames, name) {
+		result = s.appendCrossAttributeValidator(result, frameworkvalidators.AtLeastOneOfFunction, name, anyOfNames)
+	}
+
+	if dependentNames, ok := s.getDependentRequired()[name]; ok {
+		result = s.appendCrossAttributeValidator(result, frameworkvalidators.AlsoRequiresFunction, name, dependentNames)
+	}
+
+	if s.Schema.Not != nil {
+		notSchema := s.Schema.Not.Schema()
+		if isRequiredOnlySchema(notSchema) && len(notSchema.Required) == 2 && slices.Contains(notSchema.Required, name) {
+			result = s.appendCrossAttributeValidator(result, frameworkvalidators.ConflictsWithFunction, name, notSchema.Required)
+		}
+	}
+
+	return result
+}
+
+// appendCrossAttributeValidator appends a validator referencing every other property name that isn't ignored, if there are any.
+func (s *OASSchema) appendCrossAttributeValidator(validators []CrossAttributeValidator, functionName string, name string, propertyNames []string) []CrossAttributeValidator {
+	attributeNames := []string{}
+	for _, propertyName := range propertyNames {
+		if propertyName == name || s.IsPropertyIgnored(propertyName) || slices.Contains(attributeNames, util.TerraformIdentifier(propertyName)) {
+			continue
+		}
+
+		attributeNames = append(attributeNames, util.TerraformIdentifier(propertyName))
+	}
+
+	if len(attributeNames) == 0 {
+		return validators
+	}
+
+	return append(validators, CrossAttributeValidator{
+		FunctionName:   functionName,
+		AttributeNames: attributeNames,
+	})
+}
+
+// getCrossAttributeValidators returns the cross-attribute validators of the schema, as custom validators from the given validation package.
+func (s *OASSchema) getCrossAttributeValidators(packageName string) []*schema.CustomValidator {
+	var result []*schema.CustomValidator
+
+	for _, validator := range s.SchemaOpts.CrossAttributeValidators {
+		result = append(result, frameworkvalidators.PathExpressionsValidator(packageName, validator.FunctionName, validator.AttributeNames))
+	}
+
+	return result
+}
+
+// getDependentRequired returns the `dependentRequired` keyword of the schema, see dependentRequired for details.
+func (s *OASSchema) getDependentRequired() map[string][]string {
+	return dependentRequired(s.Schema)
+}
+
+// dependentRequired returns the `dependentRequired` keyword of a schema, which is only available in the low-level model. Schemas merged from
+// allOf subschemas don't have a low-level model of their own, so the merged keyword is carried in their extensions instead, see mergeSchema.
+func dependentRequired(s *base.Schema) map[string][]string {
+	if s.Extensions != nil {
+		if node, ok := s.Extensions.Get(util.OAS_keyword_dependent_required); ok {
+			return decodeDependentRequired(node)
+		}
+	}
+
+	low := s.GoLow()
+	if low == nil || low.RootNode == nil {
+		return nil
+	}
+
+	content := low.RootNode.Content
+	for i := 0; i+1 < len(content); i += 2 {
+		if content[i].Value == util.OAS_keyword_dependent_required {
+			return decodeDependentRequired(content[i+1])
+		}
+	}
+
+	return nil
+}
+
+func decodeDependentRequired(node *yaml.Node) map[string][]string {
+	var dependentRequired map[string][]string
+	if err := node.Decode(&dependentRequired); err != nil {
+		return nil
+	}
+
+	return dependentRequired
+}
+
+// isRequiredOnlyComposition returns true if every oneOf/anyOf subschema only lists required properties, which doesn't change the shape of
+// the parent schema and is mapped to cross-attribute validators instead.
+func isRequiredOnlyComposition(proxies []*base.SchemaProxy) bool {
+	if len(proxies) == 0 {
+		return false
+	}
+
+	for _, proxy := range proxies {
+		if !isRequiredOnlySchema(proxy.Schema()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isRequiredOnlySchema returns true if the schema only has the `required` keyword, i.e. `{required: [a, b]}`.
+func isRequiredOnlySchema(s *base.Schema) bool {
+	return s != nil &&
+		len(s.Required) > 0 &&
+		len(s.Type) == 0 &&
+		(s.Properties == nil || s.Properties.Len() == 0) &&
+		len(s.AllOf) == 0 && len(s.AnyOf) == 0 && len(s.OneOf) == 0 &&
+		s.Not == nil && s.Items == nil && s.AdditionalProperties == nil
+}
+
+// requiredOnlyPropertyNames returns the property names of oneOf/anyOf subschemas that each require a single property. Returns nil otherwise,
+// as requiring combinations of properties can't be mapped to a validator.
+func requiredOnlyPropertyNames(proxies []*base.SchemaProxy) []string {
+	if len(proxies) < 2 || !isRequiredOnlyComposition(proxies) {
+		return nil
+	}
+
+	var names []string
+	for _, proxy := range proxies {
+		required := proxy.Schema().Required
+		if len(required) != 1 {
+			return nil
+		}
+
+		names = append(names, required[0])
+	}
+
+	return names
+}
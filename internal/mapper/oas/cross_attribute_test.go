@@ -0,0 +1,448 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/oas"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pb33f/libopenapi"
+)
+
+func TestBuildResourceAttributes_crossAttributeValidators(t *testing.T) {
+	t.Parallel()
+
+	testOAS := `
+openapi: 3.1.0
+info:
+  title: Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    OneOfRequired:
+      type: object
+      required:
+        - name
+      properties:
+        name:
+          type: string
+        subnetNo:
+          type: string
+        vpcNo:
+          type: string
+      oneOf:
+        - required: [subnetNo]
+        - required: [vpcNo]
+    AnyOfRequired:
+      type: object
+      properties:
+        enabled:
+          type: boolean
+        port:
+          type: integer
+          format: int64
+      anyOf:
+        - required: [enabled]
+        - required: [port]
+    AnyOfRequiredIgnored:
+      type: object
+      properties:
+        enabled:
+          type: boolean
+        ignored:
+          type: string
+      anyOf:
+        - required: [enabled]
+        - required: [ignored]
+    DependentRequired:
+      type: object
+      properties:
+        tags:
+          type: array
+          items:
+            type: string
+        tagPrefix:
+          type: string
+        weight:
+          type: number
+      dependentRequired:
+        tagPrefix: [tags, weight]
+    AllOfDependentRequired:
+      allOf:
+        - $ref: '#/components/schemas/DependentRequired'
+        - type: object
+          properties:
+            weight:
+              type: number
+          dependentRequired:
+            weight: [tags]
+    AllOfOneOfRequired:
+      allOf:
+        - type: object
+          properties:
+            subnetNo:
+              type: string
+        - type: object
+          properties:
+            vpcNo:
+              type: string
+      oneOf:
+        - required: [subnetNo]
+        - required: [vpcNo]
+    NotRequired:
+      type: object
+      properties:
+        privateIp:
+          type: string
+        publicIp:
+          type: string
+      not:
+        required: [privateIp, publicIp]
+    ComputedOneOfRequired:
+      type: object
+      properties:
+        subnetNo:
+          type: string
+          readOnly: true
+        vpcNo:
+          type: string
+      oneOf:
+        - required: [subnetNo]
+        - required: [vpcNo]
+`
+
+	doc, err := libopenapi.NewDocument([]byte(testOAS))
+	if err != nil {
+		t.Fatalf("unexpected error parsing test OAS: %s", err)
+	}
+
+	model, errs := doc.BuildV3Model()
+	if len(errs) > 0 {
+		t.Fatalf("unexpected error building test OAS: %s", errors.Join(errs...))
+	}
+
+	pathImport := code.Import{Path: "github.com/hashicorp/terraform-plugin-framework/path"}
+
+	testCases := map[string]struct {
+		schemaOpts         oas.SchemaOpts
+		expectedAttributes attrmapper.ResourceAttributes
+	}{
+		"OneOfRequired": {
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "subnetNo",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.StringValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										pathImport,
+										{Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"},
+									},
+									SchemaDefinition: "stringvalidator.ExactlyOneOf(\npath.MatchRelative().AtParent().AtName(\"vpc_no\"),\n)",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "vpcNo",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.StringValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										pathImport,
+										{Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"},
+									},
+									SchemaDefinition: "stringvalidator.ExactlyOneOf(\npath.MatchRelative().AtParent().AtName(\"subnet_no\"),\n)",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"AnyOfRequired": {
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceBoolAttribute{
+					Name: "enabled",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.BoolValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										pathImport,
+										{Path: "github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"},
+									},
+									SchemaDefinition: "boolvalidator.AtLeastOneOf(\npath.MatchRelative().AtParent().AtName(\"port\"),\n)",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceInt64Attribute{
+					Name: "port",
+					Int64Attribute: resource.Int64Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.Int64Validators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										pathImport,
+										{Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator"},
+									},
+									SchemaDefinition: "int64validator.AtLeastOneOf(\npath.MatchRelative().AtParent().AtName(\"enabled\"),\n)",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"AnyOfRequiredIgnored": {
+			schemaOpts: oas.SchemaOpts{
+				Ignores: []string{"ignored"},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceBoolAttribute{
+					Name: "enabled",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+		"DependentRequired": {
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "tagPrefix",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.StringValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										pathImport,
+										{Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"},
+									},
+									SchemaDefinition: "stringvalidator.AlsoRequires(\npath.MatchRelative().AtParent().AtName(\"tags\"),\npath.MatchRelative().AtParent().AtName(\"weight\"),\n)",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceListAttribute{
+					Name: "tags",
+					ListAttribute: resource.ListAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						ElementType: schema.ElementType{
+							String: &schema.StringType{},
+						},
+					},
+				},
+				&attrmapper.ResourceNumberAttribute{
+					Name: "weight",
+					NumberAttribute: resource.NumberAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+		"AllOfDependentRequired": {
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "tagPrefix",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.StringValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										pathImport,
+										{Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"},
+									},
+									SchemaDefinition: "stringvalidator.AlsoRequires(\npath.MatchRelative().AtParent().AtName(\"tags\"),\npath.MatchRelative().AtParent().AtName(\"weight\"),\n)",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceListAttribute{
+					Name: "tags",
+					ListAttribute: resource.ListAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						ElementType: schema.ElementType{
+							String: &schema.StringType{},
+						},
+					},
+				},
+				&attrmapper.ResourceNumberAttribute{
+					Name: "weight",
+					NumberAttribute: resource.NumberAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.NumberValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										pathImport,
+										{Path: "github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator"},
+									},
+									SchemaDefinition: "numbervalidator.AlsoRequires(\npath.MatchRelative().AtParent().AtName(\"tags\"),\n)",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"AllOfOneOfRequired": {
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "subnetNo",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.StringValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										pathImport,
+										{Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"},
+									},
+									SchemaDefinition: "stringvalidator.ExactlyOneOf(\npath.MatchRelative().AtParent().AtName(\"vpc_no\"),\n)",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "vpcNo",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.StringValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										pathImport,
+										{Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"},
+									},
+									SchemaDefinition: "stringvalidator.ExactlyOneOf(\npath.MatchRelative().AtParent().AtName(\"subnet_no\"),\n)",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"NotRequired": {
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "privateIp",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.StringValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										pathImport,
+										{Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"},
+									},
+									SchemaDefinition: "stringvalidator.ConflictsWith(\npath.MatchRelative().AtParent().AtName(\"public_ip\"),\n)",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "publicIp",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.StringValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										pathImport,
+										{Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"},
+									},
+									SchemaDefinition: "stringvalidator.ConflictsWith(\npath.MatchRelative().AtParent().AtName(\"private_ip\"),\n)",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"ComputedOneOfRequired": {
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "subnetNo",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "vpcNo",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.StringValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										pathImport,
+										{Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"},
+									},
+									SchemaDefinition: "stringvalidator.ExactlyOneOf(\npath.MatchRelative().AtParent().AtName(\"subnet_no\"),\n)",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			proxy, ok := model.Model.Components.Schemas.Get(name)
+			if !ok {
+				t.Fatalf("expected %s schema in test OAS", name)
+			}
+
+			s, schemaErr := oas.BuildSchema(proxy, testCase.schemaOpts, oas.GlobalSchemaOpts{})
+			if schemaErr != nil {
+				t.Fatalf("unexpected error: %s", schemaErr)
+			}
+
+			attributes, schemaErr := s.BuildResourceAttributes()
+			if schemaErr != nil {
+				t.Fatalf("unexpected error: %s", schemaErr)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// buildDiscriminatedSchema will build an object schema from a oneOf/anyOf with a [discriminator], where each variant subschema
+// is a property of the object. The name of each variant property is determined, in order, by:
+//   - The key of the discriminator mapping that references the variant subschema
+//   - The name of the variant subschema $ref
+//   - The const or single enum value of the discriminator property in the variant subschema
+//
+// Properties of the parent schema are kept next to the variant properties, a variant with the same name as a parent property returns
+// a SchemaError. The discriminator and oneOf/anyOf keywords are preserved on the returned schema, see IsVariantObject.
+//
+// [discriminator]: https://spec.openapis.org/oas/v3.1.0#discriminator-object
+func buildDiscriminatedSchema(s *base.Schema, variantProxies []*base.SchemaProxy, nodeType NodeType) (*base.Schema, *SchemaError) {
+	properties := orderedmap.New[string, *base.SchemaProxy]()
+	for pair := range orderedmap.Iterate(context.TODO(), s.Properties) {
+		properties.Set(pair.Key(), pair.Value())
+	}
+
+	variantNames, err := getVariantNames(s.Discriminator, variantProxies)
+	if err != nil {
+		return nil, SchemaErrorFromNode(err, s, nodeType)
+	}
+
+	for i, variantName := range variantNames {
+		if _, ok := properties.Get(variantName); ok {
+			return nil, SchemaErrorFromNode(fmt.Errorf("discriminator variant '%s' conflicts with a property of the parent schema, attribute cannot be created", variantName), s, nodeType)
+		}
+
+		properties.Set(variantName, variantProxies[i])
+	}
+
+	// The parent schema is copied, which preserves the low-level model for line numbers in errors. Only the parent properties
+	// can be required, as a single variant is set at a time.
+	variantObject := *s
+	variantObject.Type = []string{util.OAS_type_object}
+	variantObject.Properties = properties
+
+	return &variantObject, nil
+}
+
+// getVariantNames returns the property names of the variant subschemas of a discriminated oneOf/anyOf, in order.
+func getVariantNames(discriminator *base.Discriminator, variantProxies []*base.SchemaProxy) ([]string, error) {
+	variantNames := make([]string, 0, len(variantProxies))
+	seen := map[string]bool{}
+
+	for _, variantProxy := range variantProxies {
+		variantName, err := getVariantName(discriminator, variantProxy)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[variantName] {
+			return nil, fmt.Errorf("found duplicate discriminator variant '%s', attribute cannot be created", variantName)
+		}
+		seen[variantName] = true
+
+		variantNames = append(variantNames, variantName)
+	}
+
+	return variantNames, nil
+}
+
+// getVariantName returns the property name for a variant subschema of a discriminated oneOf/anyOf.
+func getVariantName(discriminator *base.Discriminator, variantProxy *base.SchemaProxy) (string, error) {
+	if variantProxy.IsReference() {
+		ref := variantProxy.GetReference()
+
+		if discriminator.Mapping != nil {
+			for pair := range orderedmap.Iterate(context.TODO(), discriminator.Mapping) {
+				if pair.Value() == ref {
+					return pair.Key(), nil
+				}
+			}
+		}
+
+		refParts := strings.Split(ref, "/")
+		if refName := refParts[len(refParts)-1]; refName != "" {
+			return refName, nil
+		}
+	}
+
+	variantSchema, err := variantProxy.BuildSchema()
+	if err != nil {
+		return "", fmt.Errorf("failed to build discriminator variant schema proxy - %w", err)
+	}
+
+	if discriminator.PropertyName != "" && variantSchema.Properties != nil {
+		discriminatorProxy, ok := variantSchema.Properties.Get(discriminator.PropertyName)
+		if ok {
+			discriminatorSchema, err := discriminatorProxy.BuildSchema()
+			if err != nil {
+				return "", fmt.Errorf("failed to build discriminator property schema proxy - %w", err)
+			}
+
+			if discriminatorSchema.Const != nil && discriminatorSchema.Const.Value != "" {
+				return discriminatorSchema.Const.Value, nil
+			}
+
+			if len(discriminatorSchema.Enum) == 1 && discriminatorSchema.Enum[0].Value != "" {
+				return discriminatorSchema.Enum[0].Value, nil
+			}
+		}
+	}
+
+	return "", errors.New("unable to determine a discriminator variant name, variants must be a $ref or define a const/enum value for the discriminator property")
+}
+
+// IsVariantObject returns true if the schema was built from a oneOf/anyOf with a discriminator, where each variant is a property,
+// next to the properties of the parent schema.
+func (s *OASSchema) IsVariantObject() bool {
+	return s.Schema.Discriminator != nil && (len(s.Schema.OneOf) > 0 || len(s.Schema.AnyOf) > 0)
+}
+
+// GetVariantSiblings returns the Terraform identifiers of all other variant properties that are not ignored, if the schema
+// is a variant object and the property is a variant. Returns nil otherwise, or if the variants are computed, as they can't be configured.
+func (s *OASSchema) GetVariantSiblings(name string) []string {
+	if !s.IsVariantObject() || s.GetComputability(name) == schema.Computed {
+		return nil
+	}
+
+	// The variants are the same as when the schema was built, see buildDiscriminatedSchema
+	variantProxies := s.Schema.OneOf
+	if len(variantProxies) == 0 || isRequiredOnlyComposition(variantProxies) {
+		variantProxies = s.Schema.AnyOf
+	}
+
+	variantNames, err := getVariantNames(s.Schema.Discriminator, variantProxies)
+	if err != nil || !slices.Contains(variantNames, name) {
+		return nil
+	}
+	sort.Strings(variantNames)
+
+	siblings := []string{}
+	for _, siblingName := range variantNames {
+		if siblingName == name || s.IsPropertyIgnored(siblingName) {
+			continue
+		}
+
+		siblings = append(siblings, util.TerraformIdentifier(siblingName))
+	}
+
+	return siblings
+}
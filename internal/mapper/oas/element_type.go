@@ -15,10 +15,12 @@ func (s *OASSchema) BuildElementType() (schema.ElementType, *SchemaError) {
 	case util.OAS_type_string:
 		return s.BuildStringElementType()
 	case util.OAS_type_integer:
-		switch s.Format {
+		switch s.GetIntegerFormat() {
 		case util.OAS_format_int32:
 			return s.BuildInt32ElementType()
-		case util.OAS_format_int64:
+		case util.OAS_type_number:
+			return s.BuildNumberElementType()
+		default:
 			return s.BuildInt64ElementType()
 		}
 	case util.OAS_type_number:
@@ -36,6 +38,4 @@ func (s *OASSchema) BuildElementType() (schema.ElementType, *SchemaError) {
 	default:
 		return schema.ElementType{}, SchemaErrorFromNode(fmt.Errorf("invalid schema type '%s'", s.Type), s.Schema, Type)
 	}
-
-	return schema.ElementType{}, nil
 }
@@ -6,6 +6,7 @@ package oas
 import (
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/provider"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
@@ -19,6 +20,7 @@ func (s *OASSchema) BuildInt32Resource(name string, computability schema.Compute
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -49,6 +51,7 @@ func (s *OASSchema) BuildInt64Resource(name string, computability schema.Compute
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -79,6 +82,7 @@ func (s *OASSchema) BuildInt32DataSource(name string, computability schema.Compu
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsDataSourceSensitive(),
 		},
 	}
 
@@ -96,6 +100,7 @@ func (s *OASSchema) BuildInt64DataSource(name string, computability schema.Compu
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsDataSourceSensitive(),
 		},
 	}
 
@@ -113,6 +118,7 @@ func (s *OASSchema) BuildInt32Provider(name string, optionalOrRequired schema.Op
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
 			Validators:         s.GetInt32Validators(),
 		},
 	}
@@ -127,6 +133,7 @@ func (s *OASSchema) BuildInt64Provider(name string, optionalOrRequired schema.Op
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
 			Validators:         s.GetInt64Validators(),
 		},
 	}
@@ -146,6 +153,22 @@ func (s *OASSchema) BuildInt64ElementType() (schema.ElementType, *SchemaError) {
 	}, nil
 }
 
+// GetIntegerFormat returns the format used to map an `integer` schema. Schemas without a supported `format` (`int32` or `int64`)
+// are mapped with GlobalSchemaOpts.DefaultIntegerType, which is `int64` unless configured as `int32` or `number`.
+func (s *OASSchema) GetIntegerFormat() string {
+	switch s.Format {
+	case util.OAS_format_int32, util.OAS_format_int64:
+		return s.Format
+	}
+
+	switch s.GlobalSchemaOpts.DefaultIntegerType {
+	case util.OAS_format_int32, util.OAS_type_number:
+		return s.GlobalSchemaOpts.DefaultIntegerType
+	default:
+		return util.OAS_format_int64
+	}
+}
+
 func (s *OASSchema) GetInt32Validators() []schema.Int32Validator {
 	var result []schema.Int32Validator
 
@@ -171,8 +194,7 @@ func (s *OASSchema) GetInt32Validators() []schema.Int32Validator {
 		}
 	}
 
-	minimum := s.Schema.Minimum
-	maximum := s.Schema.Maximum
+	minimum, maximum := s.getNumericBounds().integerBounds()
 
 	if minimum != nil && maximum != nil {
 		result = append(result, schema.Int32Validator{
@@ -188,6 +210,18 @@ func (s *OASSchema) GetInt32Validators() []schema.Int32Validator {
 		})
 	}
 
+	if customValidator := s.getMultipleOfValidator(); customValidator != nil {
+		result = append(result, schema.Int32Validator{
+			Custom: customValidator,
+		})
+	}
+
+	for _, validator := range s.getCrossAttributeValidators(frameworkvalidators.Int32ValidatorPackage) {
+		result = append(result, schema.Int32Validator{
+			Custom: validator,
+		})
+	}
+
 	return result
 }
 
@@ -216,8 +250,7 @@ func (s *OASSchema) GetInt64Validators() []schema.Int64Validator {
 		}
 	}
 
-	minimum := s.Schema.Minimum
-	maximum := s.Schema.Maximum
+	minimum, maximum := s.getNumericBounds().integerBounds()
 
 	if minimum != nil && maximum != nil {
 		result = append(result, schema.Int64Validator{
@@ -233,5 +266,17 @@ func (s *OASSchema) GetInt64Validators() []schema.Int64Validator {
 		})
 	}
 
+	if customValidator := s.getMultipleOfValidator(); customValidator != nil {
+		result = append(result, schema.Int64Validator{
+			Custom: customValidator,
+		})
+	}
+
+	for _, validator := range s.getCrossAttributeValidators(frameworkvalidators.Int64ValidatorPackage) {
+		result = append(result, schema.Int64Validator{
+			Custom: validator,
+		})
+	}
+
 	return result
 }
@@ -25,6 +25,7 @@ func TestBuildIntegerResource(t *testing.T) {
 
 	testCases := map[string]struct {
 		schema             *base.Schema
+		globalSchemaOpts   oas.GlobalSchemaOpts
 		expectedAttributes attrmapper.ResourceAttributes
 	}{
 		"int64 attributes": {
@@ -177,6 +178,112 @@ func TestBuildIntegerResource(t *testing.T) {
 				},
 			},
 		},
+		"default integer type int32": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"int64_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:   []string{"integer"},
+						Format: "int64",
+					}),
+					"int_list_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"array"},
+						Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"integer"},
+							}),
+						},
+					}),
+					"int_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+					"int_prop_unknown_format": base.CreateSchemaProxy(&base.Schema{
+						Type:   []string{"integer"},
+						Format: "uint32",
+					}),
+				}),
+			},
+			globalSchemaOpts: oas.GlobalSchemaOpts{
+				DefaultIntegerType: "int32",
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceInt64Attribute{
+					Name: "int64_prop",
+					Int64Attribute: resource.Int64Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				&attrmapper.ResourceListAttribute{
+					Name: "int_list_prop",
+					ListAttribute: resource.ListAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						ElementType: schema.ElementType{
+							Int32: &schema.Int32Type{},
+						},
+					},
+				},
+				&attrmapper.ResourceInt32Attribute{
+					Name: "int_prop",
+					Int32Attribute: resource.Int32Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				&attrmapper.ResourceInt32Attribute{
+					Name: "int_prop_unknown_format",
+					Int32Attribute: resource.Int32Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+		"default integer type number": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"int32_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:   []string{"integer"},
+						Format: "int32",
+					}),
+					"int_map_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						AdditionalProperties: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"integer"},
+							}),
+						},
+					}),
+					"int_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+				}),
+			},
+			globalSchemaOpts: oas.GlobalSchemaOpts{
+				DefaultIntegerType: "number",
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceInt32Attribute{
+					Name: "int32_prop",
+					Int32Attribute: resource.Int32Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				&attrmapper.ResourceMapAttribute{
+					Name: "int_map_prop",
+					MapAttribute: resource.MapAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						ElementType: schema.ElementType{
+							Number: &schema.NumberType{},
+						},
+					},
+				},
+				&attrmapper.ResourceNumberAttribute{
+					Name: "int_prop",
+					NumberAttribute: resource.NumberAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
 		"validators": {
 			schema: &base.Schema{
 				Type:     []string{"object"},
@@ -220,7 +327,7 @@ func TestBuildIntegerResource(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			schema := oas.OASSchema{Schema: testCase.schema}
+			schema := oas.OASSchema{Schema: testCase.schema, GlobalSchemaOpts: testCase.globalSchemaOpts}
 			attributes, err := schema.BuildResourceAttributes()
 			if err != nil {
 				t.Fatalf("unexpected error: %s", err)
@@ -238,6 +345,7 @@ func TestBuildIntegerDataSource(t *testing.T) {
 
 	testCases := map[string]struct {
 		schema             *base.Schema
+		globalSchemaOpts   oas.GlobalSchemaOpts
 		expectedAttributes attrmapper.DataSourceAttributes
 	}{
 		"int64 attributes": {
@@ -340,6 +448,44 @@ func TestBuildIntegerDataSource(t *testing.T) {
 				},
 			},
 		},
+		"default integer type number": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"int_list_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"array"},
+						Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"integer"},
+							}),
+						},
+					}),
+					"int_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+				}),
+			},
+			globalSchemaOpts: oas.GlobalSchemaOpts{
+				DefaultIntegerType: "number",
+			},
+			expectedAttributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceListAttribute{
+					Name: "int_list_prop",
+					ListAttribute: datasource.ListAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						ElementType: schema.ElementType{
+							Number: &schema.NumberType{},
+						},
+					},
+				},
+				&attrmapper.DataSourceNumberAttribute{
+					Name: "int_prop",
+					NumberAttribute: datasource.NumberAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
 		"validators": {
 			schema: &base.Schema{
 				Type:     []string{"object"},
@@ -383,7 +529,7 @@ func TestBuildIntegerDataSource(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			schema := oas.OASSchema{Schema: testCase.schema}
+			schema := oas.OASSchema{Schema: testCase.schema, GlobalSchemaOpts: testCase.globalSchemaOpts}
 			attributes, err := schema.BuildDataSourceAttributes()
 			if err != nil {
 				t.Fatalf("unexpected error: %s", err)
@@ -401,6 +547,7 @@ func TestBuildIntegerProvider(t *testing.T) {
 
 	testCases := map[string]struct {
 		schema             *base.Schema
+		globalSchemaOpts   oas.GlobalSchemaOpts
 		expectedAttributes attrmapper.ProviderAttributes
 	}{
 		"int64 attributes": {
@@ -503,6 +650,44 @@ func TestBuildIntegerProvider(t *testing.T) {
 				},
 			},
 		},
+		"default integer type int32": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"int_list_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"array"},
+						Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"integer"},
+							}),
+						},
+					}),
+					"int_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+				}),
+			},
+			globalSchemaOpts: oas.GlobalSchemaOpts{
+				DefaultIntegerType: "int32",
+			},
+			expectedAttributes: attrmapper.ProviderAttributes{
+				&attrmapper.ProviderListAttribute{
+					Name: "int_list_prop",
+					ListAttribute: provider.ListAttribute{
+						OptionalRequired: schema.Optional,
+						ElementType: schema.ElementType{
+							Int32: &schema.Int32Type{},
+						},
+					},
+				},
+				&attrmapper.ProviderInt32Attribute{
+					Name: "int_prop",
+					Int32Attribute: provider.Int32Attribute{
+						OptionalRequired: schema.Optional,
+					},
+				},
+			},
+		},
 		"validators": {
 			schema: &base.Schema{
 				Type:     []string{"object"},
@@ -546,7 +731,7 @@ func TestBuildIntegerProvider(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			schema := oas.OASSchema{Schema: testCase.schema}
+			schema := oas.OASSchema{Schema: testCase.schema, GlobalSchemaOpts: testCase.globalSchemaOpts}
 			attributes, err := schema.BuildProviderAttributes()
 			if err != nil {
 				t.Fatalf("unexpected error: %s", err)
@@ -633,7 +818,7 @@ func TestGetIntegerValidators(t *testing.T) {
 								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
 							},
 						},
-						SchemaDefinition: "int64validator.Between(123, 456)",
+						SchemaDefinition: "int64validator.Between(124, 456)",
 					},
 				},
 			},
@@ -658,6 +843,140 @@ func TestGetIntegerValidators(t *testing.T) {
 				},
 			},
 		},
+		"exclusive-boolean": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"integer"},
+					Minimum: pointer(float64(0)),
+					Maximum: pointer(float64(10)),
+					ExclusiveMinimum: &base.DynamicValue[bool, float64]{
+						N: 0,
+						A: true,
+					},
+					ExclusiveMaximum: &base.DynamicValue[bool, float64]{
+						N: 0,
+						A: true,
+					},
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.Between(1, 9)",
+					},
+				},
+			},
+		},
+		"exclusive-numeric": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"integer"},
+					ExclusiveMinimum: &base.DynamicValue[bool, float64]{
+						N: 1,
+						B: 0.5,
+					},
+					ExclusiveMaximum: &base.DynamicValue[bool, float64]{
+						N: 1,
+						B: 10,
+					},
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.Between(1, 9)",
+					},
+				},
+			},
+		},
+		"exclusive-numeric-less-restrictive": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"integer"},
+					Minimum: pointer(float64(5)),
+					ExclusiveMinimum: &base.DynamicValue[bool, float64]{
+						N: 1,
+						B: 0,
+					},
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.AtLeast(5)",
+					},
+				},
+			},
+		},
+		"fractional-bounds": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"integer"},
+					Minimum: pointer(float64(1.5)),
+					Maximum: pointer(float64(9.5)),
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.Between(2, 9)",
+					},
+				},
+			},
+		},
+		"multiple-of": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"integer"},
+					MultipleOf: pointer(float64(5)),
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					SDKImportPath:  "github.com/example/terraform-provider-example/internal/ncloudsdk",
+					SDKPackageName: "ncloudsdk",
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/example/terraform-provider-example/internal/ncloudsdk",
+							},
+						},
+						SchemaDefinition: "ncloudsdk.MultipleOf(5)",
+					},
+				},
+			},
+		},
+		"multiple-of-without-sdk-import-path": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"integer"},
+					MultipleOf: pointer(float64(5)),
+				},
+			},
+			expected: nil,
+		},
 	}
 
 	for name, testCase := range testCases {
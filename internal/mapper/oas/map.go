@@ -44,6 +44,7 @@ func (s *OASSchema) BuildMapResource(name string, computability schema.ComputedO
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -66,6 +67,7 @@ func (s *OASSchema) BuildMapResource(name string, computability schema.ComputedO
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -106,6 +108,7 @@ func (s *OASSchema) BuildMapDataSource(name string, computability schema.Compute
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsDataSourceSensitive(),
 			},
 		}
 
@@ -128,6 +131,7 @@ func (s *OASSchema) BuildMapDataSource(name string, computability schema.Compute
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsDataSourceSensitive(),
 		},
 	}
 
@@ -168,6 +172,7 @@ func (s *OASSchema) BuildMapProvider(name string, optionalOrRequired schema.Opti
 				OptionalRequired:   optionalOrRequired,
 				DeprecationMessage: s.GetDeprecationMessage(),
 				Description:        s.GetDescription(),
+				Sensitive:          s.IsSensitive(),
 				Validators:         s.GetMapValidators(),
 			},
 		}
@@ -187,6 +192,7 @@ func (s *OASSchema) BuildMapProvider(name string, optionalOrRequired schema.Opti
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
 			Validators:         s.GetMapValidators(),
 		},
 	}
@@ -241,5 +247,11 @@ func (s *OASSchema) GetMapValidators() []schema.MapValidator {
 		})
 	}
 
+	for _, validator := range s.getCrossAttributeValidators(frameworkvalidators.MapValidatorPackage) {
+		result = append(result, schema.MapValidator{
+			Custom: validator,
+		})
+	}
+
 	return result
 }
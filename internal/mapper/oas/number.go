@@ -21,6 +21,7 @@ func (s *OASSchema) BuildNumberResource(name string, computability schema.Comput
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -44,14 +45,21 @@ func (s *OASSchema) BuildNumberResource(name string, computability schema.Comput
 		return result, nil
 	}
 
-	return &attrmapper.ResourceNumberAttribute{
+	result := &attrmapper.ResourceNumberAttribute{
 		Name: name,
 		NumberAttribute: resource.NumberAttribute{
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
-	}, nil
+	}
+
+	if computability != schema.Computed {
+		result.Validators = s.GetNumberValidators()
+	}
+
+	return result, nil
 }
 
 func (s *OASSchema) BuildNumberDataSource(name string, computability schema.ComputedOptionalRequired) (attrmapper.DataSourceAttribute, *SchemaError) {
@@ -62,6 +70,7 @@ func (s *OASSchema) BuildNumberDataSource(name string, computability schema.Comp
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsDataSourceSensitive(),
 			},
 		}
 
@@ -77,9 +86,14 @@ func (s *OASSchema) BuildNumberDataSource(name string, computability schema.Comp
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsDataSourceSensitive(),
 		},
 	}
 
+	if computability != schema.Computed {
+		result.Validators = s.GetNumberValidators()
+	}
+
 	return result, nil
 }
 
@@ -91,6 +105,7 @@ func (s *OASSchema) BuildNumberProvider(name string, optionalOrRequired schema.O
 				OptionalRequired:   optionalOrRequired,
 				DeprecationMessage: s.GetDeprecationMessage(),
 				Description:        s.GetDescription(),
+				Sensitive:          s.IsSensitive(),
 				Validators:         s.GetFloatValidators(),
 			},
 		}
@@ -103,6 +118,8 @@ func (s *OASSchema) BuildNumberProvider(name string, optionalOrRequired schema.O
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
+			Validators:         s.GetNumberValidators(),
 		},
 	}
 
@@ -121,6 +138,33 @@ func (s *OASSchema) BuildNumberElementType() (schema.ElementType, *SchemaError)
 	}, nil
 }
 
+func (s *OASSchema) GetNumberValidators() []schema.NumberValidator {
+	var result []schema.NumberValidator
+
+	// There are no number range or multipleOf validators, so these are mapped to the validators of the generated SDK
+	for _, customValidator := range s.numberBoundValidators(s.getNumericBounds()) {
+		if customValidator != nil {
+			result = append(result, schema.NumberValidator{
+				Custom: customValidator,
+			})
+		}
+	}
+
+	if customValidator := s.getMultipleOfValidator(); customValidator != nil {
+		result = append(result, schema.NumberValidator{
+			Custom: customValidator,
+		})
+	}
+
+	for _, validator := range s.getCrossAttributeValidators(frameworkvalidators.NumberValidatorPackage) {
+		result = append(result, schema.NumberValidator{
+			Custom: validator,
+		})
+	}
+
+	return result
+}
+
 func (s *OASSchema) GetFloatValidators() []schema.Float64Validator {
 	var result []schema.Float64Validator
 
@@ -146,5 +190,41 @@ func (s *OASSchema) GetFloatValidators() []schema.Float64Validator {
 		}
 	}
 
+	bounds := s.getNumericBounds()
+
+	if bounds.minimum != nil && bounds.maximum != nil {
+		result = append(result, schema.Float64Validator{
+			Custom: frameworkvalidators.Float64ValidatorBetween(*bounds.minimum, *bounds.maximum),
+		})
+	} else if bounds.minimum != nil {
+		result = append(result, schema.Float64Validator{
+			Custom: frameworkvalidators.Float64ValidatorAtLeast(*bounds.minimum),
+		})
+	} else if bounds.maximum != nil {
+		result = append(result, schema.Float64Validator{
+			Custom: frameworkvalidators.Float64ValidatorAtMost(*bounds.maximum),
+		})
+	}
+
+	// There are no exclusive range validators, so the exclusive bounds are excluded separately
+	customValidator := frameworkvalidators.Float64ValidatorNoneOf(bounds.exclusiveValues())
+	if customValidator != nil {
+		result = append(result, schema.Float64Validator{
+			Custom: customValidator,
+		})
+	}
+
+	if customValidator := s.getMultipleOfValidator(); customValidator != nil {
+		result = append(result, schema.Float64Validator{
+			Custom: customValidator,
+		})
+	}
+
+	for _, validator := range s.getCrossAttributeValidators(frameworkvalidators.Float64ValidatorPackage) {
+		result = append(result, schema.Float64Validator{
+			Custom: validator,
+		})
+	}
+
 	return result
 }
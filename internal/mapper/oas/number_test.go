@@ -1110,6 +1110,170 @@ func TestGetFloatValidators(t *testing.T) {
 				},
 			},
 		},
+		"minimum": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"number"},
+					Minimum: pointer(float64(1.5)),
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.AtLeast(1.5)",
+					},
+				},
+			},
+		},
+		"maximum": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"number"},
+					Maximum: pointer(float64(99.9)),
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.AtMost(99.9)",
+					},
+				},
+			},
+		},
+		"maximum-and-minimum": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"number"},
+					Minimum: pointer(float64(1.5)),
+					Maximum: pointer(float64(99.9)),
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.Between(1.5, 99.9)",
+					},
+				},
+			},
+		},
+		"exclusive-boolean": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"number"},
+					Minimum: pointer(float64(0)),
+					Maximum: pointer(float64(1)),
+					ExclusiveMinimum: &base.DynamicValue[bool, float64]{
+						N: 0,
+						A: true,
+					},
+					ExclusiveMaximum: &base.DynamicValue[bool, float64]{
+						N: 0,
+						A: false,
+					},
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.Between(0, 1)",
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.NoneOf(\n0,\n)",
+					},
+				},
+			},
+		},
+		"exclusive-numeric": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"number"},
+					Minimum: pointer(float64(-5)),
+					ExclusiveMinimum: &base.DynamicValue[bool, float64]{
+						N: 1,
+						B: 0,
+					},
+					ExclusiveMaximum: &base.DynamicValue[bool, float64]{
+						N: 1,
+						B: 100,
+					},
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.Between(0, 100)",
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.NoneOf(\n0,\n100,\n)",
+					},
+				},
+			},
+		},
+		"multiple-of": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"number"},
+					Format:     "double",
+					MultipleOf: pointer(float64(0.5)),
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					SDKImportPath:  "github.com/example/terraform-provider-example/internal/sdk",
+					SDKPackageName: "ncloudsdk",
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Alias: pointer("ncloudsdk"),
+								Path:  "github.com/example/terraform-provider-example/internal/sdk",
+							},
+						},
+						SchemaDefinition: "ncloudsdk.MultipleOf(0.5)",
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -1126,3 +1290,149 @@ func TestGetFloatValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNumberValidators(t *testing.T) {
+	t.Parallel()
+
+	sdkImport := []code.Import{
+		{
+			Path: "github.com/example/terraform-provider-example/internal/ncloudsdk",
+		},
+	}
+	globalSchemaOpts := oas.GlobalSchemaOpts{
+		SDKImportPath:  "github.com/example/terraform-provider-example/internal/ncloudsdk",
+		SDKPackageName: "ncloudsdk",
+	}
+
+	testCases := map[string]struct {
+		schema   oas.OASSchema
+		expected []schema.NumberValidator
+	}{
+		"none": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"number"},
+				},
+				GlobalSchemaOpts: globalSchemaOpts,
+			},
+			expected: nil,
+		},
+		"maximum-and-minimum": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"number"},
+					Minimum: pointer(float64(1.5)),
+					Maximum: pointer(float64(100)),
+				},
+				GlobalSchemaOpts: globalSchemaOpts,
+			},
+			expected: []schema.NumberValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports:          sdkImport,
+						SchemaDefinition: "ncloudsdk.NumberAtLeast(1.5)",
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						Imports:          sdkImport,
+						SchemaDefinition: "ncloudsdk.NumberAtMost(100)",
+					},
+				},
+			},
+		},
+		"exclusive-boolean": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"number"},
+					Minimum: pointer(float64(0)),
+					Maximum: pointer(float64(100)),
+					ExclusiveMinimum: &base.DynamicValue[bool, float64]{
+						N: 0,
+						A: true,
+					},
+					ExclusiveMaximum: &base.DynamicValue[bool, float64]{
+						N: 0,
+						A: true,
+					},
+				},
+				GlobalSchemaOpts: globalSchemaOpts,
+			},
+			expected: []schema.NumberValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports:          sdkImport,
+						SchemaDefinition: "ncloudsdk.NumberGreaterThan(0)",
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						Imports:          sdkImport,
+						SchemaDefinition: "ncloudsdk.NumberLessThan(100)",
+					},
+				},
+			},
+		},
+		"exclusive-numeric": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"number"},
+					ExclusiveMinimum: &base.DynamicValue[bool, float64]{
+						N: 1,
+						B: 0.5,
+					},
+				},
+				GlobalSchemaOpts: globalSchemaOpts,
+			},
+			expected: []schema.NumberValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports:          sdkImport,
+						SchemaDefinition: "ncloudsdk.NumberGreaterThan(0.5)",
+					},
+				},
+			},
+		},
+		"multiple-of": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"number"},
+					MultipleOf: pointer(float64(0.01)),
+				},
+				GlobalSchemaOpts: globalSchemaOpts,
+			},
+			expected: []schema.NumberValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports:          sdkImport,
+						SchemaDefinition: "ncloudsdk.MultipleOf(0.01)",
+					},
+				},
+			},
+		},
+		"without-sdk-import-path": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"number"},
+					Minimum:    pointer(float64(1)),
+					MultipleOf: pointer(float64(0.01)),
+				},
+			},
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.GetNumberValidators()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"math"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+)
+
+// numericBounds are the lower and upper bounds of a numeric schema, where each bound is either inclusive or exclusive.
+type numericBounds struct {
+	minimum          *float64
+	maximum          *float64
+	exclusiveMinimum bool
+	exclusiveMaximum bool
+}
+
+// getNumericBounds returns the bounds from the `minimum`, `maximum`, `exclusiveMinimum`, and `exclusiveMaximum` keywords, which
+// supports both the OAS 3.0 boolean form (modifies `minimum`/`maximum`) and the OAS 3.1 numeric form (a separate bound) of the
+// exclusive keywords. If both an inclusive and exclusive bound are set, the most restrictive bound is used.
+func (s *OASSchema) getNumericBounds() numericBounds {
+	bounds := numericBounds{
+		minimum: s.Schema.Minimum,
+		maximum: s.Schema.Maximum,
+	}
+
+	if exclusiveMinimum := s.Schema.ExclusiveMinimum; exclusiveMinimum != nil {
+		if exclusiveMinimum.IsA() {
+			bounds.exclusiveMinimum = exclusiveMinimum.A && bounds.minimum != nil
+		} else if bounds.minimum == nil || exclusiveMinimum.B >= *bounds.minimum {
+			bounds.minimum = &exclusiveMinimum.B
+			bounds.exclusiveMinimum = true
+		}
+	}
+
+	if exclusiveMaximum := s.Schema.ExclusiveMaximum; exclusiveMaximum != nil {
+		if exclusiveMaximum.IsA() {
+			bounds.exclusiveMaximum = exclusiveMaximum.A && bounds.maximum != nil
+		} else if bounds.maximum == nil || exclusiveMaximum.B <= *bounds.maximum {
+			bounds.maximum = &exclusiveMaximum.B
+			bounds.exclusiveMaximum = true
+		}
+	}
+
+	return bounds
+}
+
+// integerBounds returns the inclusive integer bounds, i.e. an exclusive minimum of 1 is an inclusive minimum of 2, and a fractional
+// minimum of 1.5 is an inclusive minimum of 2.
+func (b numericBounds) integerBounds() (*int64, *int64) {
+	var minimum, maximum *int64
+
+	if b.minimum != nil {
+		value := int64(math.Ceil(*b.minimum))
+		if b.exclusiveMinimum {
+			value = int64(math.Floor(*b.minimum)) + 1
+		}
+
+		minimum = &value
+	}
+
+	if b.maximum != nil {
+		value := int64(math.Floor(*b.maximum))
+		if b.exclusiveMaximum {
+			value = int64(math.Ceil(*b.maximum)) - 1
+		}
+
+		maximum = &value
+	}
+
+	return minimum, maximum
+}
+
+// exclusiveValues returns the exclusive bounds, which must be excluded in addition to an inclusive range validator.
+func (b numericBounds) exclusiveValues() []float64 {
+	var values []float64
+
+	if b.minimum != nil && b.exclusiveMinimum {
+		values = append(values, *b.minimum)
+	}
+
+	if b.maximum != nil && b.exclusiveMaximum {
+		values = append(values, *b.maximum)
+	}
+
+	return values
+}
+
+// numberBoundValidators returns the SDK validators of the bounds, for number attributes that have no range validators in
+// terraform-plugin-framework-validators.
+func (s *OASSchema) numberBoundValidators(bounds numericBounds) []*schema.CustomValidator {
+	var result []*schema.CustomValidator
+
+	if bounds.minimum != nil {
+		functionName := frameworkvalidators.NumberAtLeastFunction
+		if bounds.exclusiveMinimum {
+			functionName = frameworkvalidators.NumberGreaterThanFunction
+		}
+
+		result = append(result, s.getSDKValidator(functionName, *bounds.minimum))
+	}
+
+	if bounds.maximum != nil {
+		functionName := frameworkvalidators.NumberAtMostFunction
+		if bounds.exclusiveMaximum {
+			functionName = frameworkvalidators.NumberLessThanFunction
+		}
+
+		result = append(result, s.getSDKValidator(functionName, *bounds.maximum))
+	}
+
+	return result
+}
+
+// getMultipleOfValidator returns the SDK validator of the `multipleOf` keyword, or nil if it's not set.
+func (s *OASSchema) getMultipleOfValidator() *schema.CustomValidator {
+	if s.Schema.MultipleOf == nil {
+		return nil
+	}
+
+	return s.getSDKValidator(frameworkvalidators.MultipleOfFunction, *s.Schema.MultipleOf)
+}
+
+// getSDKValidator returns a validator of the generated SDK package, or nil if the SDK import path isn't set.
+func (s *OASSchema) getSDKValidator(functionName string, value float64) *schema.CustomValidator {
+	return frameworkvalidators.SDKValidator(s.GlobalSchemaOpts.SDKImportPath, s.GlobalSchemaOpts.SDKPackageName, functionName, value)
+}
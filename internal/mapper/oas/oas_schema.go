@@ -5,7 +5,6 @@ package oas
 
 import (
 	"context"
-	"strings"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
@@ -31,6 +30,16 @@ type GlobalSchemaOpts struct {
 	// create request for a resource, does not become required from a lower precedence operation, such as an
 	// read response for a resource.
 	OverrideComputability schema.ComputedOptionalRequired
+
+	// DefaultIntegerType is the type used to map `integer` schemas that have a missing or unsupported `format`, one
+	// of `int32`, `int64`, or `number`. When empty, these schemas are mapped as `int64`.
+	DefaultIntegerType string
+
+	// SDKImportPath and SDKPackageName are the import path and package name of the generated SDK, which contains the validators that
+	// terraform-plugin-framework-validators has no equivalent for, i.e. `multipleOf`. These validators are only mapped if SDKImportPath
+	// is set, as they can't be referenced otherwise.
+	SDKImportPath  string
+	SDKPackageName string
 }
 
 // SchemaOpts is NOT passed recursively through built OASSchema structs, and will only be available to the top level schema. This is used
@@ -48,6 +57,13 @@ type SchemaOpts struct {
 	// OverrideDescription will set the attribute description to this field if populated, otherwise the attribute description
 	// will be set to the description field of the `schema`.
 	OverrideDescription string
+
+	// VariantSiblings contains the names of all sibling attributes, if the schema is a variant of a oneOf/anyOf with a discriminator.
+	// An "exactly one of" validator will be added to the attribute with these names.
+	VariantSiblings []string
+
+	// CrossAttributeValidators contains the validators that reference sibling attributes, mapped from the keywords of the parent object schema.
+	CrossAttributeValidators []CrossAttributeValidator
 }
 
 // IsMap checks the `additionalProperties` field to determine if a map type is appropriate (refer to [JSON Schema - additionalProperties]).
@@ -118,7 +134,21 @@ func (s *OASSchema) GetDescription() *string {
 	return &s.Schema.Description
 }
 
+// IsSensitive returns true if the schema is a password, or is write-only. Write-only properties are typically secrets that are never
+// returned by the API. Used for resources and providers, see IsDataSourceSensitive for data sources.
 func (s *OASSchema) IsSensitive() *bool {
+	isSensitive := s.Format == util.OAS_format_password || s.IsWriteOnly()
+
+	if !isSensitive {
+		return nil
+	}
+
+	return &isSensitive
+}
+
+// IsDataSourceSensitive returns true if the schema is a password. Unlike IsSensitive, write-only properties aren't sensitive, as they are
+// the inputs of the read request of a data source, i.e. filters, rather than secrets.
+func (s *OASSchema) IsDataSourceSensitive() *bool {
 	isSensitive := s.Format == util.OAS_format_password
 
 	if !isSensitive {
@@ -128,6 +158,16 @@ func (s *OASSchema) IsSensitive() *bool {
 	return &isSensitive
 }
 
+// IsReadOnly returns true if the `readOnly` keyword is enabled, meaning the property is only returned by the API and is never sent in a request.
+func (s *OASSchema) IsReadOnly() bool {
+	return s.Schema.ReadOnly != nil && *s.Schema.ReadOnly
+}
+
+// IsWriteOnly returns true if the `writeOnly` keyword is enabled, meaning the property is only sent in a request and is never returned by the API.
+func (s *OASSchema) IsWriteOnly() bool {
+	return s.Schema.WriteOnly != nil && *s.Schema.WriteOnly
+}
+
 // TODO: Figure out a better way to handle computability, since it differs with provider vs. datasource/resource
 func (s *OASSchema) GetComputability(name string) schema.ComputedOptionalRequired {
 	if s.GlobalSchemaOpts.OverrideComputability != "" {
@@ -153,31 +193,25 @@ func (s *OASSchema) GetOptionalOrRequired(name string) schema.OptionalRequired {
 	return schema.Optional
 }
 
-// IsPropertyIgnored checks if a property should be ignored
+// IsPropertyIgnored checks if a property should be ignored, see util.ApplyIgnore for the supported wildcards
 func (s *OASSchema) IsPropertyIgnored(name string) bool {
 	for _, ignore := range s.SchemaOpts.Ignores {
-		if name == ignore {
+		if ignored, _ := util.ApplyIgnore(ignore, name); ignored {
 			return true
 		}
 	}
 	return false
 }
 
-// GetIgnoresForNested is a helper function that will return all nested ignores for a property. If no ignores
-// or nested ignores are found, returns an empty string slice.
+// GetIgnoresForNested is a helper function that will return all nested ignores for a property, including any
+// wildcard ignores that continue to apply to nested properties. If no ignores or nested ignores are found,
+// returns an empty string slice.
 func (s *OASSchema) GetIgnoresForNested(name string) []string {
 	newIgnores := make([]string, 0)
 
 	for _, ignore := range s.SchemaOpts.Ignores {
-		ignoreParts := strings.Split(ignore, ".")
-
-		if len(ignoreParts) > 1 && name == ignoreParts[0] {
-			newIgnore := strings.Join(ignoreParts[1:], ".")
-
-			if newIgnore != "" {
-				newIgnores = append(newIgnores, newIgnore)
-			}
-		}
+		_, nestedIgnores := util.ApplyIgnore(ignore, name)
+		newIgnores = append(newIgnores, nestedIgnores...)
 	}
 
 	return newIgnores
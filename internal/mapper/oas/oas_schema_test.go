@@ -169,6 +169,39 @@ func TestIsPropertyIgnored(t *testing.T) {
 			},
 			want: false,
 		},
+		"propery is ignored - wildcard": {
+			propertyName: "internal_id",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Ignores: []string{
+						"internal_*",
+					},
+				},
+			},
+			want: true,
+		},
+		"propery is ignored - recursive wildcard": {
+			propertyName: "regionCode",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Ignores: []string{
+						"**.regionCode",
+					},
+				},
+			},
+			want: true,
+		},
+		"nested propery is not ignored - wildcard": {
+			propertyName: "prop",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Ignores: []string{
+						"*.createDate",
+					},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -234,6 +267,24 @@ func TestGetIgnoresForNested(t *testing.T) {
 				"ignore_me_3",
 			},
 		},
+		"nested wildcard ignores exist": {
+			propertyName: "prop_list",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Ignores: []string{
+						"*.createDate",
+						"**.regionCode",
+						"prop_*[*].internal_*",
+						"other_*.id",
+					},
+				},
+			},
+			want: []string{
+				"createDate",
+				"**.regionCode",
+				"internal_*",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
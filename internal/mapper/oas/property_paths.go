@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"context"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// PropertyPaths returns the dot-separated location of every property in the schema, including the nested properties of objects,
+// array items, and map values. The locations are in the same format as the `ignores` and `overrides` generator config options.
+func (s *OASSchema) PropertyPaths() []string {
+	paths := []string{}
+	collectPropertyPaths(s.Schema, "", map[string]bool{}, func(path string, _ *base.Schema) bool {
+		paths = append(paths, path)
+		return true
+	})
+
+	return paths
+}
+
+// RequestPropertyPaths returns the location of every property in the schema that can be sent in a request, which skips `readOnly`
+// properties and their nested properties.
+func (s *OASSchema) RequestPropertyPaths() []string {
+	paths := []string{}
+	collectPropertyPaths(s.Schema, "", map[string]bool{}, func(path string, pSchema *base.Schema) bool {
+		if pSchema.ReadOnly != nil && *pSchema.ReadOnly {
+			return false
+		}
+
+		paths = append(paths, path)
+		return true
+	})
+
+	return paths
+}
+
+// WriteOnlyPropertyPaths returns the location of every `writeOnly` property in the schema. Nested properties of a `writeOnly` property
+// are not included.
+func (s *OASSchema) WriteOnlyPropertyPaths() []string {
+	paths := []string{}
+	collectPropertyPaths(s.Schema, "", map[string]bool{}, func(path string, pSchema *base.Schema) bool {
+		if pSchema.WriteOnly != nil && *pSchema.WriteOnly {
+			paths = append(paths, path)
+			return false
+		}
+
+		return true
+	})
+
+	return paths
+}
+
+// collectPropertyPaths will recursively visit the properties of a schema, only visiting nested properties if visit returns true. Any
+// schema references already being visited are not visited again, to prevent infinite recursion on circular references.
+func collectPropertyPaths(s *base.Schema, prefix string, refs map[string]bool, visit func(path string, pSchema *base.Schema) bool) {
+	if s.Properties != nil {
+		for pair := range orderedmap.Iterate(context.TODO(), s.Properties) {
+			path := prefix + pair.Key()
+
+			pSchema, err := buildSchemaProxy(pair.Value())
+			if err != nil {
+				// Schemas that can't be built will also fail during mapping, so there are no nested properties to collect
+				pSchema = &base.Schema{}
+			}
+
+			if visit(path, pSchema) {
+				collectProxyPropertyPaths(pair.Value(), path+".", refs, visit)
+			}
+		}
+	}
+
+	if s.Items != nil && s.Items.IsA() {
+		collectProxyPropertyPaths(s.Items.A, prefix, refs, visit)
+	}
+
+	if s.AdditionalProperties != nil && s.AdditionalProperties.IsA() {
+		collectProxyPropertyPaths(s.AdditionalProperties.A, prefix, refs, visit)
+	}
+}
+
+func collectProxyPropertyPaths(proxy *base.SchemaProxy, prefix string, refs map[string]bool, visit func(path string, pSchema *base.Schema) bool) {
+	if proxy == nil {
+		return
+	}
+
+	if proxy.IsReference() {
+		ref := proxy.GetReference()
+		if refs[ref] {
+			return
+		}
+
+		refs[ref] = true
+		defer delete(refs, ref)
+	}
+
+	s, err := buildSchemaProxy(proxy)
+	if err != nil {
+		return
+	}
+
+	collectPropertyPaths(s, prefix, refs, visit)
+}
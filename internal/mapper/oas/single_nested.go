@@ -5,6 +5,7 @@ package oas
 
 import (
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/provider"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
@@ -24,6 +25,8 @@ func (s *OASSchema) BuildSingleNestedResource(name string, computability schema.
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
+			Validators:               s.GetObjectValidators(),
 		},
 	}, nil
 }
@@ -41,6 +44,8 @@ func (s *OASSchema) BuildSingleNestedDataSource(name string, computability schem
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsDataSourceSensitive(),
+			Validators:               s.GetObjectValidators(),
 		},
 	}, nil
 }
@@ -58,6 +63,26 @@ func (s *OASSchema) BuildSingleNestedProvider(name string, optionalOrRequired sc
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
+			Validators:         s.GetObjectValidators(),
 		},
 	}, nil
 }
+
+func (s *OASSchema) GetObjectValidators() []schema.ObjectValidator {
+	var result []schema.ObjectValidator
+
+	if len(s.SchemaOpts.VariantSiblings) > 0 {
+		result = append(result, schema.ObjectValidator{
+			Custom: frameworkvalidators.ObjectValidatorExactlyOneOf(s.SchemaOpts.VariantSiblings),
+		})
+	}
+
+	for _, validator := range s.getCrossAttributeValidators(frameworkvalidators.ObjectValidatorPackage) {
+		result = append(result, schema.ObjectValidator{
+			Custom: validator,
+		})
+	}
+
+	return result
+}
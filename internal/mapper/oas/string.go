@@ -5,7 +5,9 @@ package oas
 
 import (
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkcustomtypes"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/provider"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
@@ -20,6 +22,7 @@ func (s *OASSchema) BuildStringResource(name string, computability schema.Comput
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
 			Sensitive:                s.IsSensitive(),
+			CustomType:               s.GetStringCustomType(),
 		},
 	}
 
@@ -50,7 +53,8 @@ func (s *OASSchema) BuildStringDataSource(name string, computability schema.Comp
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
-			Sensitive:                s.IsSensitive(),
+			Sensitive:                s.IsDataSourceSensitive(),
+			CustomType:               s.GetStringCustomType(),
 		},
 	}
 
@@ -69,6 +73,7 @@ func (s *OASSchema) BuildStringProvider(name string, optionalOrRequired schema.O
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
 			Sensitive:          s.IsSensitive(),
+			CustomType:         s.GetStringCustomType(),
 			Validators:         s.GetStringValidators(),
 		},
 	}
@@ -78,10 +83,59 @@ func (s *OASSchema) BuildStringProvider(name string, optionalOrRequired schema.O
 
 func (s *OASSchema) BuildStringElementType() (schema.ElementType, *SchemaError) {
 	return schema.ElementType{
-		String: &schema.StringType{},
+		String: &schema.StringType{
+			CustomType: s.GetStringCustomType(),
+		},
 	}, nil
 }
 
+// GetStringCustomType returns a framework custom type for string formats that have semantic equality, or nil if the format has no
+// custom type. Strings with a `contentMediaType` of `application/json`, or the `x-json-string` extension, are mapped to normalized JSON.
+func (s *OASSchema) GetStringCustomType() *schema.CustomType {
+	switch s.Format {
+	case util.OAS_format_date_time:
+		return frameworkcustomtypes.RFC3339()
+	case util.OAS_format_ipv4:
+		return frameworkcustomtypes.IPv4Address()
+	case util.OAS_format_ipv6:
+		return frameworkcustomtypes.IPv6Address()
+	case util.OAS_format_ipv4_cidr:
+		return frameworkcustomtypes.IPv4Prefix()
+	case util.OAS_format_ipv6_cidr:
+		return frameworkcustomtypes.IPv6Prefix()
+	}
+
+	if s.IsJSONString() {
+		return frameworkcustomtypes.NormalizedJSON()
+	}
+
+	return nil
+}
+
+// IsJSONString returns true if the string contains JSON, which is either marked with a `contentMediaType` of `application/json`, or
+// with the `x-json-string` extension.
+func (s *OASSchema) IsJSONString() bool {
+	if low := s.Schema.GoLow(); low != nil && low.ContentMediaType.Value == util.OAS_mediatype_json {
+		return true
+	}
+
+	if s.Schema.Extensions == nil {
+		return false
+	}
+
+	extension, ok := s.Schema.Extensions.Get(util.OAS_extension_json_string)
+	if !ok || extension == nil {
+		return false
+	}
+
+	var isJSONString bool
+	if err := extension.Decode(&isJSONString); err != nil {
+		return false
+	}
+
+	return isJSONString
+}
+
 func (s *OASSchema) GetStringValidators() []schema.StringValidator {
 	var result []schema.StringValidator
 
@@ -132,5 +186,11 @@ func (s *OASSchema) GetStringValidators() []schema.StringValidator {
 		})
 	}
 
+	for _, validator := range s.getCrossAttributeValidators(frameworkvalidators.StringValidatorPackage) {
+		result = append(result, schema.StringValidator{
+			Custom: validator,
+		})
+	}
+
 	return result
 }
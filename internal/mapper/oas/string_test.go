@@ -4,9 +4,11 @@
 package oas_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkcustomtypes"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/oas"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
@@ -16,6 +18,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/pb33f/libopenapi"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	"github.com/pb33f/libopenapi/orderedmap"
 )
@@ -111,6 +114,90 @@ func TestBuildStringResource(t *testing.T) {
 				},
 			},
 		},
+		"string attributes read-only and write-only": {
+			schema: &base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"string_read_only_prop", "string_write_only_prop"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"string_read_only_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:     []string{"string"},
+						ReadOnly: pointer(true),
+					}),
+					"string_write_only_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:      []string{"string"},
+						WriteOnly: pointer(true),
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_read_only_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_write_only_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Sensitive:                pointer(true),
+					},
+				},
+			},
+		},
+		"string attributes custom types": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"string_date_time_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:   []string{"string"},
+						Format: "date-time",
+					}),
+					"string_json_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+						Extensions: orderedmap.ToOrderedMap(map[string]*yaml.Node{
+							"x-json-string": {Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"},
+						}),
+					}),
+					"string_list_ipv4_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"array"},
+						Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type:   []string{"string"},
+								Format: "ipv4",
+							}),
+						},
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_date_time_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						CustomType:               frameworkcustomtypes.RFC3339(),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_json_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						CustomType:               frameworkcustomtypes.NormalizedJSON(),
+					},
+				},
+				&attrmapper.ResourceListAttribute{
+					Name: "string_list_ipv4_prop",
+					ListAttribute: resource.ListAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						ElementType: schema.ElementType{
+							String: &schema.StringType{
+								CustomType: frameworkcustomtypes.IPv4Address(),
+							},
+						},
+					},
+				},
+			},
+		},
 		"string attributes deprecated": {
 			schema: &base.Schema{
 				Type: []string{"object"},
@@ -276,6 +363,48 @@ func TestBuildStringDataSource(t *testing.T) {
 				},
 			},
 		},
+		"string attributes read-only and write-only": {
+			schema: &base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"string_read_only_prop", "string_write_only_prop"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"string_read_only_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:     []string{"string"},
+						ReadOnly: pointer(true),
+					}),
+					"string_write_only_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:      []string{"string"},
+						WriteOnly: pointer(true),
+					}),
+					"string_password_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:   []string{"string"},
+						Format: "password",
+					}),
+				}),
+			},
+			// Write-only properties are filters of the read request of a data source, only passwords are sensitive
+			expectedAttributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceStringAttribute{
+					Name: "string_password_prop",
+					StringAttribute: datasource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Sensitive:                pointer(true),
+					},
+				},
+				&attrmapper.DataSourceStringAttribute{
+					Name: "string_read_only_prop",
+					StringAttribute: datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+				&attrmapper.DataSourceStringAttribute{
+					Name: "string_write_only_prop",
+					StringAttribute: datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+		},
 		"string attributes deprecated": {
 			schema: &base.Schema{
 				Type: []string{"object"},
@@ -441,6 +570,31 @@ func TestBuildStringProvider(t *testing.T) {
 				},
 			},
 		},
+		"string attributes read-only and write-only": {
+			schema: &base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"string_read_only_prop", "string_write_only_prop"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"string_read_only_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:     []string{"string"},
+						ReadOnly: pointer(true),
+					}),
+					"string_write_only_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:      []string{"string"},
+						WriteOnly: pointer(true),
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ProviderAttributes{
+				&attrmapper.ProviderStringAttribute{
+					Name: "string_write_only_prop",
+					StringAttribute: provider.StringAttribute{
+						OptionalRequired: schema.Required,
+						Sensitive:        pointer(true),
+					},
+				},
+			},
+		},
 		"string attributes deprecated": {
 			schema: &base.Schema{
 				Type: []string{"object"},
@@ -565,6 +719,102 @@ func TestBuildStringProvider(t *testing.T) {
 	}
 }
 
+func TestGetStringCustomType(t *testing.T) {
+	t.Parallel()
+
+	testOAS := `
+openapi: 3.1.0
+info:
+  title: Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    NoFormat:
+      type: string
+    Password:
+      type: string
+      format: password
+    DateTime:
+      type: string
+      format: date-time
+    IPv4:
+      type: string
+      format: ipv4
+    IPv6:
+      type: string
+      format: ipv6
+    IPv4CIDR:
+      type: string
+      format: ipv4-cidr
+    IPv6CIDR:
+      type: string
+      format: ipv6-cidr
+    ContentMediaTypeJSON:
+      type: string
+      contentMediaType: application/json
+    ContentMediaTypeText:
+      type: string
+      contentMediaType: text/plain
+    ExtensionJSON:
+      type: string
+      x-json-string: true
+    ExtensionNotJSON:
+      type: string
+      x-json-string: false
+`
+
+	doc, err := libopenapi.NewDocument([]byte(testOAS))
+	if err != nil {
+		t.Fatalf("unexpected error parsing test OAS: %s", err)
+	}
+
+	model, errs := doc.BuildV3Model()
+	if len(errs) > 0 {
+		t.Fatalf("unexpected error building test OAS: %s", errors.Join(errs...))
+	}
+
+	testCases := map[string]struct {
+		expected *schema.CustomType
+	}{
+		"NoFormat":             {expected: nil},
+		"Password":             {expected: nil},
+		"DateTime":             {expected: frameworkcustomtypes.RFC3339()},
+		"IPv4":                 {expected: frameworkcustomtypes.IPv4Address()},
+		"IPv6":                 {expected: frameworkcustomtypes.IPv6Address()},
+		"IPv4CIDR":             {expected: frameworkcustomtypes.IPv4Prefix()},
+		"IPv6CIDR":             {expected: frameworkcustomtypes.IPv6Prefix()},
+		"ContentMediaTypeJSON": {expected: frameworkcustomtypes.NormalizedJSON()},
+		"ContentMediaTypeText": {expected: nil},
+		"ExtensionJSON":        {expected: frameworkcustomtypes.NormalizedJSON()},
+		"ExtensionNotJSON":     {expected: nil},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			proxy, ok := model.Model.Components.Schemas.Get(name)
+			if !ok {
+				t.Fatalf("expected %s schema in test OAS", name)
+			}
+
+			s, schemaErr := oas.BuildSchema(proxy, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+			if schemaErr != nil {
+				t.Fatalf("unexpected error: %s", schemaErr)
+			}
+
+			got := s.GetStringCustomType()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestGetStringValidators(t *testing.T) {
 	t.Parallel()
 
@@ -27,8 +27,7 @@ type ProviderWithEndpoint struct {
 
 type providerMapper struct {
 	provider explorer.Provider
-	//nolint:unused // Might be useful later!
-	cfg config.Config
+	cfg      config.Config
 }
 
 func NewProviderMapper(exploredProvider explorer.Provider, cfg config.Config) ProviderMapper {
@@ -52,7 +51,7 @@ func (m providerMapper) MapToIR(logger *slog.Logger) (*ProviderWithEndpoint, err
 
 	pLogger := logger.With("provider", providerIR.Name)
 
-	providerSchema, err := generateProviderSchema(pLogger, m.provider)
+	providerSchema, err := generateProviderSchema(pLogger, m.provider, m.cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -61,13 +60,13 @@ func (m providerMapper) MapToIR(logger *slog.Logger) (*ProviderWithEndpoint, err
 	return &providerIR, nil
 }
 
-func generateProviderSchema(logger *slog.Logger, exploredProvider explorer.Provider) (*provider.Schema, error) {
+func generateProviderSchema(logger *slog.Logger, exploredProvider explorer.Provider, cfg config.Config) (*provider.Schema, error) {
 	providerSchema := &provider.Schema{}
 
 	schemaOpts := oas.SchemaOpts{
 		Ignores: exploredProvider.Ignores,
 	}
-	s, err := oas.BuildSchema(exploredProvider.SchemaProxy, schemaOpts, oas.GlobalSchemaOpts{})
+	s, err := oas.BuildSchema(exploredProvider.SchemaProxy, schemaOpts, newGlobalSchemaOpts(cfg, ""))
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +83,7 @@ func (m resourceMapper) MapToIR(logger *slog.Logger) ([]DetailResourceInfo, erro
 			refreshObjectName = s[len(s)-1]
 		}
 
-		schema, err := generateResourceSchema(rLogger, explorerResource)
+		schema, err := generateResourceSchema(rLogger, explorerResource, m.cfg)
 		if err != nil {
 			log.WarnLogOnError(rLogger, err, "skipping resource schema mapping")
 			continue
@@ -104,20 +104,34 @@ func (m resourceMapper) MapToIR(logger *slog.Logger) ([]DetailResourceInfo, erro
 	return resourceSchemas, nil
 }
 
-func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resource) (*resource.Schema, error) {
+func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resource, cfg config.Config) (*resource.Schema, error) {
 	resourceSchema := &resource.Schema{
 		Attributes: []resource.Attribute{},
 	}
 
 	// ********************
-	// Create Request Body (required)
+	// Create Request Body or Parameters (required)
 	// ********************
-	logger.Debug("searching for create operation request body")
-
 	schemaOpts := oas.SchemaOpts{
 		Ignores: explorerResource.SchemaOptions.Ignores,
 	}
-	createRequestSchema, err := oas.BuildSchemaFromRequest(explorerResource.CreateOp, schemaOpts, oas.GlobalSchemaOpts{})
+
+	var createRequestSchema *oas.OASSchema
+	var err error
+	if explorerResource.SchemaOptions.CreateFromParameters {
+		logger.Debug("searching for create operation parameters")
+
+		createRequestSchema, err = oas.BuildSchemaFromParameters(
+			explorerResource.CreateOpParameters(),
+			explorerResource.SchemaOptions.AttributeOptions.Aliases,
+			schemaOpts,
+			newGlobalSchemaOpts(cfg, ""),
+		)
+	} else {
+		logger.Debug("searching for create operation request body")
+
+		createRequestSchema, err = oas.BuildSchemaFromRequest(explorerResource.CreateOp, schemaOpts, newGlobalSchemaOpts(cfg, ""))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -135,10 +149,8 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 	schemaOpts = oas.SchemaOpts{
 		Ignores: explorerResource.SchemaOptions.Ignores,
 	}
-	globalSchemaOpts := oas.GlobalSchemaOpts{
-		OverrideComputability: schema.Computed,
-	}
-	createResponseSchema, err := oas.BuildSchemaFromResponse(explorerResource.CreateOp, schemaOpts, globalSchemaOpts)
+	globalSchemaOpts := newGlobalSchemaOpts(cfg, schema.Computed)
+	createResponseSchema, err := oas.BuildSchemaFromResponsePath(explorerResource.CreateOp, explorerResource.CreateResponsePath, schemaOpts, globalSchemaOpts)
 	if err != nil {
 		if errors.Is(err, oas.ErrSchemaNotFound) {
 			// Demote log to INFO if there was no schema found
@@ -163,10 +175,8 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 	schemaOpts = oas.SchemaOpts{
 		Ignores: explorerResource.SchemaOptions.Ignores,
 	}
-	globalSchemaOpts = oas.GlobalSchemaOpts{
-		OverrideComputability: schema.Computed,
-	}
-	readResponseSchema, err := oas.BuildSchemaFromResponse(explorerResource.ReadOp, schemaOpts, globalSchemaOpts)
+	globalSchemaOpts = newGlobalSchemaOpts(cfg, schema.Computed)
+	readResponseSchema, err := oas.BuildSchemaFromResponsePath(explorerResource.ReadOp, explorerResource.ReadResponsePath, schemaOpts, globalSchemaOpts)
 	if err != nil {
 		if errors.Is(err, oas.ErrSchemaNotFound) {
 			// Demote log to INFO if there was no schema found
@@ -195,7 +205,7 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 			Ignores:             explorerResource.SchemaOptions.Ignores,
 			OverrideDescription: param.Description,
 		}
-		globalSchemaOpts := oas.GlobalSchemaOpts{OverrideComputability: schema.ComputedOptional}
+		globalSchemaOpts := newGlobalSchemaOpts(cfg, schema.ComputedOptional)
 
 		s, schemaErr := oas.BuildSchema(param.Schema, schemaOpts, globalSchemaOpts)
 		if schemaErr != nil {
@@ -226,9 +236,159 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 	// TODO: currently, no errors can be returned from merging, but in the future we should consider raising errors/warnings for unexpected scenarios, like type mismatches between attribute schemas
 	resourceAttributes, _ := createRequestAttributes.Merge(createResponseAttributes, readResponseAttributes, readParameterAttributes)
 
-	// TODO: handle error for overrides
-	resourceAttributes, _ = resourceAttributes.ApplyOverrides(explorerResource.SchemaOptions.AttributeOptions.Overrides)
+	updatePaths := updateRequestPaths(explorerResource)
+
+	resourceAttributes, err = resourceAttributes.ApplyOverrides(requiresReplaceOverrides(explorerResource, createRequestSchema, updatePaths))
+	log.WarnLogOnError(logger, err, "skipping requires replace plan modifiers")
+
+	resourceAttributes, err = resourceAttributes.ApplyOverrides(useStateForUnknownOverrides(explorerResource, createRequestSchema, updatePaths, createResponseSchema, readResponseSchema))
+	log.WarnLogOnError(logger, err, "skipping use state for unknown plan modifiers")
+
+	resourceAttributes, err = resourceAttributes.ApplyOverrides(explorerResource.SchemaOptions.AttributeOptions.Overrides)
+	log.WarnLogOnError(logger, err, "skipping invalid attribute overrides")
 
 	resourceSchema.Attributes = resourceAttributes.ToSpec()
 	return resourceSchema, nil
 }
+
+// updateRequestPaths returns the attribute locations in the request bodies and parameters of every update operation.
+func updateRequestPaths(explorerResource explorer.Resource) map[string]bool {
+	updatePaths := map[string]bool{}
+
+	for _, updateOp := range explorerResource.UpdateOps {
+		updateRequestSchema, err := oas.BuildSchemaFromRequest(updateOp, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+		if err == nil {
+			for _, path := range updateRequestSchema.RequestPropertyPaths() {
+				updatePaths[path] = true
+			}
+		}
+
+		// RPC-style update operations can carry inputs as parameters
+		if updateOp != nil {
+			addParameterPaths(updateOp.Parameters, explorerResource.SchemaOptions.AttributeOptions.Aliases, updatePaths, map[string]bool{})
+		}
+	}
+
+	return updatePaths
+}
+
+// requiresReplaceOverrides returns overrides that add the RequiresReplace plan modifier to create-only attributes, which are in the create
+// request but not in any update request, so they can't be changed in place. Nested attributes of a create-only attribute, ignored attributes,
+// and attributes with a `requires_replace` override in the generator config are skipped.
+func requiresReplaceOverrides(explorerResource explorer.Resource, createRequestSchema *oas.OASSchema, updatePaths map[string]bool) map[string]explorer.Override {
+	requiresReplace := true
+	overrides := map[string]explorer.Override{}
+	createOnlyPaths := map[string]bool{}
+
+	for _, path := range createRequestSchema.RequestPropertyPaths() {
+		if updatePaths[path] || hasParentPath(path, createOnlyPaths) || isPathIgnored(path, explorerResource.SchemaOptions.Ignores) {
+			continue
+		}
+		createOnlyPaths[path] = true
+
+		if override, ok := explorerResource.SchemaOptions.AttributeOptions.Overrides[path]; ok && override.RequiresReplace != nil {
+			continue
+		}
+
+		overrides[path] = explorer.Override{RequiresReplace: &requiresReplace}
+	}
+
+	return overrides
+}
+
+// useStateForUnknownOverrides returns overrides that add the UseStateForUnknown plan modifier to computed attributes that are only in the
+// create or read response bodies, which are never sent in a request so they won't change after creation, and to `writeOnly` attributes in
+// the create request, which are never returned by the API. Nested attributes of a response-only or request attribute, ignored attributes, and attributes with a `use_state_for_unknown` override in the generator config
+// are skipped. Setting `use_state_for_unknown: false` in the resource schema options skips the entire resource.
+func useStateForUnknownOverrides(explorerResource explorer.Resource, createRequestSchema *oas.OASSchema, updatePaths map[string]bool, responseSchemas ...*oas.OASSchema) map[string]explorer.Override {
+	overrides := map[string]explorer.Override{}
+
+	if explorerResource.SchemaOptions.UseStateForUnknown != nil && !*explorerResource.SchemaOptions.UseStateForUnknown {
+		return overrides
+	}
+
+	requestPaths := map[string]bool{}
+	for _, path := range createRequestSchema.RequestPropertyPaths() {
+		requestPaths[path] = true
+	}
+	addParameterPaths(explorerResource.ReadOpParameters(), explorerResource.SchemaOptions.AttributeOptions.Aliases, requestPaths, map[string]bool{})
+
+	useStateForUnknown := true
+	responseOnlyPaths := map[string]bool{}
+
+	// Write-only attributes are never returned by the API, so the prior state is kept if they aren't configured. The Read of the provider
+	// must still copy the prior state of configured ones, see the write-only attributes limitation in DESIGN.md
+	for _, path := range createRequestSchema.WriteOnlyPropertyPaths() {
+		if isPathIgnored(path, explorerResource.SchemaOptions.Ignores) {
+			continue
+		}
+
+		if override, ok := explorerResource.SchemaOptions.AttributeOptions.Overrides[path]; ok && override.UseStateForUnknown != nil {
+			continue
+		}
+
+		overrides[path] = explorer.Override{UseStateForUnknown: &useStateForUnknown}
+	}
+
+	for _, responseSchema := range responseSchemas {
+		if responseSchema == nil {
+			continue
+		}
+
+		for _, path := range responseSchema.PropertyPaths() {
+			if requestPaths[path] || updatePaths[path] || hasParentPath(path, requestPaths) || hasParentPath(path, updatePaths) {
+				continue
+			}
+
+			if responseOnlyPaths[path] || hasParentPath(path, responseOnlyPaths) || isPathIgnored(path, explorerResource.SchemaOptions.Ignores) {
+				continue
+			}
+			responseOnlyPaths[path] = true
+
+			if override, ok := explorerResource.SchemaOptions.AttributeOptions.Overrides[path]; ok && override.UseStateForUnknown != nil {
+				continue
+			}
+
+			overrides[path] = explorer.Override{UseStateForUnknown: &useStateForUnknown}
+		}
+	}
+
+	return overrides
+}
+
+// hasParentPath returns true if any parent location of a dot-separated attribute location is in paths.
+func hasParentPath(path string, paths map[string]bool) bool {
+	for i := range path {
+		if path[i] == '.' && paths[path[:i]] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isPathIgnored returns true if an ignore matches a dot-separated attribute location, or any of its parent locations.
+func isPathIgnored(path string, ignores []string) bool {
+	names := strings.Split(path, ".")
+
+	for i := range names {
+		parentPath := strings.Join(names[:i+1], ".")
+		for _, ignore := range ignores {
+			if util.MatchIgnore(ignore, parentPath) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// newGlobalSchemaOpts returns the global schema options from the generator config, with an optional computability override.
+func newGlobalSchemaOpts(cfg config.Config, overrideComputability schema.ComputedOptionalRequired) oas.GlobalSchemaOpts {
+	return oas.GlobalSchemaOpts{
+		OverrideComputability: overrideComputability,
+		DefaultIntegerType:    cfg.Defaults.IntegerType,
+		SDKImportPath:         cfg.SDK.ImportPath,
+		SDKPackageName:        cfg.SDK.Package,
+	}
+}
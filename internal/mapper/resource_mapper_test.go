@@ -10,6 +10,7 @@ import (
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/config"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
@@ -1064,6 +1065,695 @@ func TestResourceMapper_basic_merges(t *testing.T) {
 	}
 }
 
+func TestResourceMapper_create_from_parameters(t *testing.T) {
+	t.Parallel()
+
+	createOp := &high.Operation{
+		Parameters: []*high.Parameter{
+			{
+				Name:     "serverName",
+				In:       "query",
+				Required: pointer(true),
+				Schema: base.CreateSchemaProxy(&base.Schema{
+					Type:        []string{"string"},
+					Description: "hey this is a string, required!",
+				}),
+			},
+			{
+				Name: "serverDescription",
+				In:   "query",
+				Schema: base.CreateSchemaProxy(&base.Schema{
+					Type:        []string{"string"},
+					Description: "hey this is a string!",
+				}),
+			},
+		},
+	}
+	readResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"serverInstanceNo": base.CreateSchemaProxy(&base.Schema{
+				Type:        []string{"string"},
+				Description: "hey this is a computed string!",
+			}),
+			"serverName": base.CreateSchemaProxy(&base.Schema{
+				Type:        []string{"string"},
+				Description: "this one already exists, so you shouldn't see this description!",
+			}),
+		}),
+	})
+
+	want := resource.Attributes{
+		{
+			Name: "server_description",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Description:              pointer("hey this is a string!"),
+				PlanModifiers: schema.StringPlanModifiers{
+					{
+						Custom: frameworkplanmodifiers.RequiresReplace(frameworkplanmodifiers.StringPlanModifierPackage),
+					},
+				},
+			},
+		},
+		{
+			Name: "server_name",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.Required,
+				Description:              pointer("hey this is a string, required!"),
+				PlanModifiers: schema.StringPlanModifiers{
+					{
+						Custom: frameworkplanmodifiers.RequiresReplace(frameworkplanmodifiers.StringPlanModifierPackage),
+					},
+				},
+			},
+		},
+		{
+			Name: "server_instance_no",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.Computed,
+				Description:              pointer("hey this is a computed string!"),
+				PlanModifiers: schema.StringPlanModifiers{
+					{
+						Custom: frameworkplanmodifiers.UseStateForUnknown(frameworkplanmodifiers.StringPlanModifierPackage),
+					},
+				},
+			},
+		},
+	}
+
+	mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+		"test_resource": {
+			CreateOp: createOp,
+			ReadOp:   createTestReadOp(readResponseSchema, nil),
+			SchemaOptions: explorer.SchemaOptions{
+				CreateFromParameters: true,
+			},
+		},
+	}, config.Config{
+		Resources: map[string]config.Resource{
+			"test_resource": {
+				Create: &config.OpenApiSpecLocation{
+					Path:   "/createServerInstances",
+					Method: "GET",
+				},
+				Read: &config.OpenApiSpecLocation{
+					Path:   "/getServerInstanceDetail",
+					Method: "GET",
+				},
+				Delete: &config.OpenApiSpecLocation{
+					Path:   "/deleteServerInstances",
+					Method: "GET",
+				},
+				RefreshObjectName: "TestResource",
+			},
+		},
+	})
+	got, err := mapper.MapToIR(slog.Default())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("expected only one resource, got: %d", len(got))
+	}
+
+	if diff := cmp.Diff(got[0].Schema.Attributes, want); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestResourceMapper_requires_replace(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"name", "region"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"region": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"tags": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"array"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+			}),
+			"config": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"size": base.CreateSchemaProxy(&base.Schema{
+						Type:   []string{"integer"},
+						Format: "int64",
+					}),
+					"zone": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+		}),
+	})
+	updateRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"config": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"size": base.CreateSchemaProxy(&base.Schema{
+						Type:   []string{"integer"},
+						Format: "int64",
+					}),
+				}),
+			}),
+		}),
+	})
+	readResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"id": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+
+	requiresReplace := false
+	want := resource.Attributes{
+		{
+			Name: "config",
+			SingleNested: &resource.SingleNestedAttribute{
+				Attributes: resource.Attributes{
+					{
+						Name: "size",
+						Int64: &resource.Int64Attribute{
+							ComputedOptionalRequired: schema.ComputedOptional,
+						},
+					},
+					{
+						Name: "zone",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.ComputedOptional,
+							PlanModifiers: schema.StringPlanModifiers{
+								{
+									Custom: frameworkplanmodifiers.RequiresReplace(frameworkplanmodifiers.StringPlanModifierPackage),
+								},
+							},
+						},
+					},
+				},
+				ComputedOptionalRequired: schema.ComputedOptional,
+			},
+		},
+		{
+			Name: "name",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.Required,
+			},
+		},
+		{
+			Name: "region",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.Required,
+				PlanModifiers: schema.StringPlanModifiers{
+					{
+						Custom: frameworkplanmodifiers.RequiresReplace(frameworkplanmodifiers.StringPlanModifierPackage),
+					},
+				},
+			},
+		},
+		{
+			Name: "tags",
+			List: &resource.ListAttribute{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				ElementType: schema.ElementType{
+					String: &schema.StringType{},
+				},
+			},
+		},
+		{
+			Name: "id",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.Computed,
+				PlanModifiers: schema.StringPlanModifiers{
+					{
+						Custom: frameworkplanmodifiers.UseStateForUnknown(frameworkplanmodifiers.StringPlanModifierPackage),
+					},
+				},
+			},
+		},
+	}
+
+	mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+		"test_resource": {
+			CreateOp: createTestCreateOp(createRequestSchema, nil),
+			ReadOp:   createTestReadOp(readResponseSchema, nil),
+			UpdateOps: []*high.Operation{
+				{
+					RequestBody: &high.RequestBody{
+						Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
+							"application/json": {
+								Schema: updateRequestSchema,
+							},
+						}),
+					},
+				},
+			},
+			UpdateLocations: []explorer.OperationLocation{
+				{Path: "/test_resource/{id}", Method: "PUT"},
+			},
+			SchemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Overrides: map[string]explorer.Override{
+						"tags": {
+							RequiresReplace: &requiresReplace,
+						},
+					},
+				},
+			},
+		},
+	}, config.Config{
+		Resources: map[string]config.Resource{
+			"test_resource": {
+				RefreshObjectName: "TestResource",
+			},
+		},
+	})
+	got, err := mapper.MapToIR(slog.Default())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("expected only one resource, got: %d", len(got))
+	}
+
+	if diff := cmp.Diff(got[0].Schema.Attributes, want); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestResourceMapper_use_state_for_unknown(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"name"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+	createResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"id": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"status": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+	readResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"id": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"network": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"ip": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+			"updated_at": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+	updateRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"updated_at": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+
+	useStateForUnknown := false
+
+	testCases := map[string]struct {
+		schemaOptions explorer.SchemaOptions
+		want          resource.Attributes
+	}{
+		"response-only attributes": {
+			schemaOptions: explorer.SchemaOptions{},
+			want: resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				{
+					Name: "id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: frameworkplanmodifiers.UseStateForUnknown(frameworkplanmodifiers.StringPlanModifierPackage),
+							},
+						},
+					},
+				},
+				{
+					Name: "status",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: frameworkplanmodifiers.UseStateForUnknown(frameworkplanmodifiers.StringPlanModifierPackage),
+							},
+						},
+					},
+				},
+				{
+					Name: "network",
+					SingleNested: &resource.SingleNestedAttribute{
+						Attributes: resource.Attributes{
+							{
+								Name: "ip",
+								String: &resource.StringAttribute{
+									ComputedOptionalRequired: schema.Computed,
+								},
+							},
+						},
+						ComputedOptionalRequired: schema.Computed,
+						PlanModifiers: schema.ObjectPlanModifiers{
+							{
+								Custom: frameworkplanmodifiers.UseStateForUnknown(frameworkplanmodifiers.ObjectPlanModifierPackage),
+							},
+						},
+					},
+				},
+				{
+					Name: "updated_at",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+		},
+		"attribute override disabled": {
+			schemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Overrides: map[string]explorer.Override{
+						"status": {
+							UseStateForUnknown: &useStateForUnknown,
+						},
+					},
+				},
+			},
+			want: resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				{
+					Name: "id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: frameworkplanmodifiers.UseStateForUnknown(frameworkplanmodifiers.StringPlanModifierPackage),
+							},
+						},
+					},
+				},
+				{
+					Name: "status",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+				{
+					Name: "network",
+					SingleNested: &resource.SingleNestedAttribute{
+						Attributes: resource.Attributes{
+							{
+								Name: "ip",
+								String: &resource.StringAttribute{
+									ComputedOptionalRequired: schema.Computed,
+								},
+							},
+						},
+						ComputedOptionalRequired: schema.Computed,
+						PlanModifiers: schema.ObjectPlanModifiers{
+							{
+								Custom: frameworkplanmodifiers.UseStateForUnknown(frameworkplanmodifiers.ObjectPlanModifierPackage),
+							},
+						},
+					},
+				},
+				{
+					Name: "updated_at",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+		},
+		"resource disabled": {
+			schemaOptions: explorer.SchemaOptions{
+				UseStateForUnknown: &useStateForUnknown,
+			},
+			want: resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				{
+					Name: "id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+				{
+					Name: "status",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+				{
+					Name: "network",
+					SingleNested: &resource.SingleNestedAttribute{
+						Attributes: resource.Attributes{
+							{
+								Name: "ip",
+								String: &resource.StringAttribute{
+									ComputedOptionalRequired: schema.Computed,
+								},
+							},
+						},
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+				{
+					Name: "updated_at",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(createRequestSchema, createResponseSchema),
+					ReadOp:   createTestReadOp(readResponseSchema, nil),
+					UpdateOps: []*high.Operation{
+						{
+							RequestBody: &high.RequestBody{
+								Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
+									"application/json": {
+										Schema: updateRequestSchema,
+									},
+								}),
+							},
+						},
+					},
+					UpdateLocations: []explorer.OperationLocation{
+						{Path: "/test_resource/{id}", Method: "PUT"},
+					},
+					SchemaOptions: testCase.schemaOptions,
+				},
+			}, config.Config{
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						RefreshObjectName: "TestResource",
+					},
+				},
+			})
+			got, err := mapper.MapToIR(slog.Default())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_read_only_write_only(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"id", "name", "password"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"id": base.CreateSchemaProxy(&base.Schema{
+				Type:     []string{"string"},
+				ReadOnly: pointer(true),
+			}),
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"password": base.CreateSchemaProxy(&base.Schema{
+				Type:      []string{"string"},
+				WriteOnly: pointer(true),
+			}),
+		}),
+	})
+	updateRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"password": base.CreateSchemaProxy(&base.Schema{
+				Type:      []string{"string"},
+				WriteOnly: pointer(true),
+			}),
+		}),
+	})
+	readResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"id": base.CreateSchemaProxy(&base.Schema{
+				Type:     []string{"string"},
+				ReadOnly: pointer(true),
+			}),
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+
+	want := resource.Attributes{
+		{
+			Name: "id",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.Computed,
+				PlanModifiers: schema.StringPlanModifiers{
+					{
+						Custom: frameworkplanmodifiers.UseStateForUnknown(frameworkplanmodifiers.StringPlanModifierPackage),
+					},
+				},
+			},
+		},
+		{
+			Name: "name",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.Required,
+			},
+		},
+		{
+			Name: "password",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.Required,
+				PlanModifiers: schema.StringPlanModifiers{
+					{
+						Custom: frameworkplanmodifiers.UseStateForUnknown(frameworkplanmodifiers.StringPlanModifierPackage),
+					},
+				},
+				Sensitive: pointer(true),
+			},
+		},
+	}
+
+	mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+		"test_resource": {
+			CreateOp: createTestCreateOp(createRequestSchema, nil),
+			ReadOp:   createTestReadOp(readResponseSchema, nil),
+			UpdateOps: []*high.Operation{
+				{
+					RequestBody: &high.RequestBody{
+						Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
+							"application/json": {
+								Schema: updateRequestSchema,
+							},
+						}),
+					},
+				},
+			},
+			UpdateLocations: []explorer.OperationLocation{
+				{Path: "/test_resource/{id}", Method: "PUT"},
+			},
+		},
+	}, config.Config{
+		Resources: map[string]config.Resource{
+			"test_resource": {
+				RefreshObjectName: "TestResource",
+			},
+		},
+	})
+	got, err := mapper.MapToIR(slog.Default())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("expected only one resource, got: %d", len(got))
+	}
+
+	if diff := cmp.Diff(got[0].Schema.Attributes, want); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
 func createTestCreateOp(request *base.SchemaProxy, response *base.SchemaProxy) *high.Operation {
 	return &high.Operation{
 		RequestBody: &high.RequestBody{
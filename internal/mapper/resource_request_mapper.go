@@ -101,11 +101,11 @@ func generateResourceRequestType(logger *slog.Logger, explorerResource explorer.
 			RequestType: spec.RequestType{
 				Response: response,
 			},
-			Parameters:  extractParametersInfo(explorerResource.CreateOp),
+			Parameters:  extractParametersInfo(explorerResource.CreateOpParameters()),
 			RequestBody: requestBody,
 		},
-		Method: config.Resources[name].Create.Method,
-		Path:   config.Resources[name].Create.Path,
+		Method: explorerResource.CreateLocation.Method,
+		Path:   explorerResource.CreateLocation.Path,
 	}
 
 	logger.Debug("searching for read operation parameters and request body")
@@ -122,16 +122,16 @@ func generateResourceRequestType(logger *slog.Logger, explorerResource explorer.
 			RequestType: spec.RequestType{
 				Response: response,
 			},
-			Parameters:  extractParametersInfo(explorerResource.ReadOp),
+			Parameters:  extractParametersInfo(operationParameters(explorerResource.ReadOp)),
 			RequestBody: requestBody,
 		},
-		Method: config.Resources[name].Read.Method,
-		Path:   config.Resources[name].Read.Path,
+		Method: explorerResource.ReadLocation.Method,
+		Path:   explorerResource.ReadLocation.Path,
 	}
 
 	logger.Debug("searching for update operation parameters and request body")
 	var updateRequest []*NcloudCommonRequestType
-	for _, updateOp := range explorerResource.UpdateOps {
+	for i, updateOp := range explorerResource.UpdateOps {
 		requestBody, err = extractRequestBody(updateOp, schemaOpts)
 		if err != nil {
 			log.WarnLogOnError(logger, err, "skipping mapping of update operation rquest body")
@@ -145,11 +145,11 @@ func generateResourceRequestType(logger *slog.Logger, explorerResource explorer.
 				RequestType: spec.RequestType{
 					Response: response,
 				},
-				Parameters:  extractParametersInfo(updateOp),
+				Parameters:  extractParametersInfo(operationParameters(updateOp)),
 				RequestBody: requestBody,
 			},
-			Method: config.Resources[name].Update[0].Method,
-			Path:   config.Resources[name].Update[0].Path,
+			Method: explorerResource.UpdateLocations[i].Method,
+			Path:   explorerResource.UpdateLocations[i].Path,
 		})
 	}
 
@@ -167,11 +167,11 @@ func generateResourceRequestType(logger *slog.Logger, explorerResource explorer.
 			RequestType: spec.RequestType{
 				Response: response,
 			},
-			Parameters:  extractParametersInfo(explorerResource.DeleteOp),
+			Parameters:  extractParametersInfo(operationParameters(explorerResource.DeleteOp)),
 			RequestBody: requestBody,
 		},
-		Method: config.Resources[name].Delete.Method,
-		Path:   config.Resources[name].Delete.Path,
+		Method: explorerResource.DeleteLocation.Method,
+		Path:   explorerResource.DeleteLocation.Path,
 	}
 
 	return CRUDParameters{
@@ -182,14 +182,23 @@ func generateResourceRequestType(logger *slog.Logger, explorerResource explorer.
 	}, nil
 }
 
-func extractParametersInfo(op *high.Operation) *RequestParameters {
-	if op == nil || op.Parameters == nil {
+// operationParameters returns the parameters of an operation, or nil if the operation isn't defined.
+func operationParameters(op *high.Operation) []*high.Parameter {
+	if op == nil {
+		return nil
+	}
+
+	return op.Parameters
+}
+
+func extractParametersInfo(params []*high.Parameter) *RequestParameters {
+	if len(params) == 0 {
 		return nil
 	}
 
 	var requiredParams []*RequestParameterAttributes
 	var optionalParams []*RequestParameterAttributes
-	for _, param := range op.Parameters {
+	for _, param := range params {
 		p := &RequestParameterAttributes{
 			Name:   param.Name,
 			Type:   param.Schema.Schema().Type[0],
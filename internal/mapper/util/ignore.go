@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	// IgnoreWildcard matches any characters in a single segment of an ignore, i.e. `*`, `internal_*`, or `*_date`
+	IgnoreWildcard = "*"
+
+	// IgnoreRecursiveWildcard matches zero or more segments of an ignore, i.e. `**.regionCode`
+	IgnoreRecursiveWildcard = "**"
+
+	// ignoreArrayItems is an optional suffix for an array segment, i.e. `server_list[*].name`. Array items are not a separate
+	// segment when mapping, so it's equivalent to `server_list.name`
+	ignoreArrayItems = "[*]"
+)
+
+// ApplyIgnore will match a property name against the first segment(s) of an ignore (dot-separated). Returns true if the property
+// itself is ignored, along with any remaining ignores that should be applied to the nested properties of the property.
+//   - ApplyIgnore("id", "id") = true, []
+//   - ApplyIgnore("*.createDate", "server") = false, [createDate]
+//   - ApplyIgnore("**.regionCode", "regionCode") = true, [**.regionCode]
+//   - ApplyIgnore("server_list[*].internal_*", "server_list") = false, [internal_*]
+func ApplyIgnore(ignore string, name string) (bool, []string) {
+	ignored := false
+	nestedIgnores := []string{}
+
+	for _, remaining := range consumeIgnoreSegment(ignoreSegments(ignore), name) {
+		if isIgnoreComplete(remaining) {
+			ignored = true
+		}
+
+		if nestedIgnore := strings.Join(remaining, "."); nestedIgnore != "" {
+			nestedIgnores = append(nestedIgnores, nestedIgnore)
+		}
+	}
+
+	return ignored, nestedIgnores
+}
+
+// MatchIgnore returns true if an ignore matches a dot-separated property path, i.e. the property at that path would be ignored.
+func MatchIgnore(ignore string, path string) bool {
+	ignores := []string{ignore}
+
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		nextIgnores := []string{}
+
+		for _, ignore := range ignores {
+			ignored, nestedIgnores := ApplyIgnore(ignore, name)
+			if ignored && i == len(names)-1 {
+				return true
+			}
+
+			nextIgnores = append(nextIgnores, nestedIgnores...)
+		}
+
+		ignores = nextIgnores
+	}
+
+	return false
+}
+
+func ignoreSegments(ignore string) []string {
+	segments := strings.Split(ignore, ".")
+	for i, segment := range segments {
+		segments[i] = strings.TrimSuffix(segment, ignoreArrayItems)
+	}
+
+	return segments
+}
+
+// consumeIgnoreSegment returns all possible remaining segments of an ignore after matching a property name. A recursive wildcard
+// can either match the property name and remain, or match nothing and be skipped.
+func consumeIgnoreSegment(segments []string, name string) [][]string {
+	if len(segments) == 0 {
+		return nil
+	}
+
+	if segments[0] == IgnoreRecursiveWildcard {
+		return append([][]string{segments}, consumeIgnoreSegment(segments[1:], name)...)
+	}
+
+	if !matchIgnoreSegment(segments[0], name) {
+		return nil
+	}
+
+	return [][]string{segments[1:]}
+}
+
+// isIgnoreComplete returns true if there are no remaining segments to match, other than recursive wildcards that can match nothing.
+func isIgnoreComplete(segments []string) bool {
+	for _, segment := range segments {
+		if segment != IgnoreRecursiveWildcard {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchIgnoreSegment(segment string, name string) bool {
+	if !strings.Contains(segment, IgnoreWildcard) {
+		return segment == name
+	}
+
+	parts := strings.Split(segment, IgnoreWildcard)
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$").MatchString(name)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestApplyIgnore(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ignore      string
+		name        string
+		wantIgnored bool
+		wantNested  []string
+	}{
+		"exact match": {
+			ignore:      "id",
+			name:        "id",
+			wantIgnored: true,
+			wantNested:  []string{},
+		},
+		"no match": {
+			ignore:      "id",
+			name:        "name",
+			wantIgnored: false,
+			wantNested:  []string{},
+		},
+		"nested exact match": {
+			ignore:      "server.id",
+			name:        "server",
+			wantIgnored: false,
+			wantNested:  []string{"id"},
+		},
+		"single segment wildcard": {
+			ignore:      "*.createDate",
+			name:        "server",
+			wantIgnored: false,
+			wantNested:  []string{"createDate"},
+		},
+		"prefix wildcard": {
+			ignore:      "internal_*",
+			name:        "internal_id",
+			wantIgnored: true,
+			wantNested:  []string{},
+		},
+		"suffix wildcard": {
+			ignore:      "*_date",
+			name:        "create_date",
+			wantIgnored: true,
+			wantNested:  []string{},
+		},
+		"prefix wildcard - no match": {
+			ignore:      "internal_*",
+			name:        "id_internal",
+			wantIgnored: false,
+			wantNested:  []string{},
+		},
+		"recursive wildcard - match": {
+			ignore:      "**.regionCode",
+			name:        "regionCode",
+			wantIgnored: true,
+			wantNested:  []string{"**.regionCode"},
+		},
+		"recursive wildcard - no match": {
+			ignore:      "**.regionCode",
+			name:        "server",
+			wantIgnored: false,
+			wantNested:  []string{"**.regionCode"},
+		},
+		"recursive wildcard - alone": {
+			ignore:      "**",
+			name:        "server",
+			wantIgnored: true,
+			wantNested:  []string{"**"},
+		},
+		"array items suffix": {
+			ignore:      "server_list[*].internal_*",
+			name:        "server_list",
+			wantIgnored: false,
+			wantNested:  []string{"internal_*"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotIgnored, gotNested := util.ApplyIgnore(testCase.ignore, testCase.name)
+
+			if gotIgnored != testCase.wantIgnored {
+				t.Errorf("expected ignored: %t, got: %t", testCase.wantIgnored, gotIgnored)
+			}
+
+			if diff := cmp.Diff(gotNested, testCase.wantNested); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMatchIgnore(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ignore string
+		path   string
+		want   bool
+	}{
+		"exact match": {
+			ignore: "server.id",
+			path:   "server.id",
+			want:   true,
+		},
+		"parent of ignore": {
+			ignore: "server.id",
+			path:   "server",
+			want:   false,
+		},
+		"single segment wildcard": {
+			ignore: "*.createDate",
+			path:   "server.createDate",
+			want:   true,
+		},
+		"single segment wildcard - too deep": {
+			ignore: "*.createDate",
+			path:   "server.disk.createDate",
+			want:   false,
+		},
+		"recursive wildcard - root": {
+			ignore: "**.regionCode",
+			path:   "regionCode",
+			want:   true,
+		},
+		"recursive wildcard - deeply nested": {
+			ignore: "**.regionCode",
+			path:   "server.zone.regionCode",
+			want:   true,
+		},
+		"recursive wildcard - middle": {
+			ignore: "server.**.id",
+			path:   "server.zone.region.id",
+			want:   true,
+		},
+		"recursive wildcard - no match": {
+			ignore: "**.regionCode",
+			path:   "server.zone.regionName",
+			want:   false,
+		},
+		"array items suffix with wildcard": {
+			ignore: "server_list[*].internal_*",
+			path:   "server_list.internal_ip",
+			want:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := util.MatchIgnore(testCase.ignore, testCase.path)
+			if got != testCase.want {
+				t.Errorf("expected: %t, got: %t", testCase.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import "strings"
+
+// JSONPointerTokens splits a [JSON Pointer] into its unescaped reference tokens. An empty pointer, which references the
+// whole document, will return no tokens.
+//   - /getServerInstanceListResponse/serverInstanceList/0 = [getServerInstanceListResponse serverInstanceList 0]
+//   - /a~1b/c~0d = [a/b c~d]
+//
+// [JSON Pointer]: https://datatracker.ietf.org/doc/html/rfc6901
+func JSONPointerTokens(pointer string) []string {
+	if pointer == "" {
+		return nil
+	}
+
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, token := range tokens {
+		token = strings.ReplaceAll(token, "~1", "/")
+		tokens[i] = strings.ReplaceAll(token, "~0", "~")
+	}
+
+	return tokens
+}
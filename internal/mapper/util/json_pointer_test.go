@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestJSONPointerTokens(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pointer string
+		want    []string
+	}{
+		"empty pointer": {
+			pointer: "",
+			want:    nil,
+		},
+		"nested properties and array index": {
+			pointer: "/getServerInstanceListResponse/serverInstanceList/0",
+			want:    []string{"getServerInstanceListResponse", "serverInstanceList", "0"},
+		},
+		"escaped characters": {
+			pointer: "/a~1b/c~0d",
+			want:    []string{"a/b", "c~d"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := util.JSONPointerTokens(testCase.pointer)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -22,11 +22,18 @@ const (
 	OAS_type_object  = "object"
 	OAS_type_null    = "null"
 
-	OAS_format_double   = "double"
-	OAS_format_float    = "float"
-	OAS_format_password = "password"
-	OAS_format_int32    = "int32"
-	OAS_format_int64    = "int64"
+	OAS_format_double    = "double"
+	OAS_format_float     = "float"
+	OAS_format_password  = "password"
+	OAS_format_int32     = "int32"
+	OAS_format_int64     = "int64"
+	OAS_format_date_time = "date-time"
+	OAS_format_ipv4      = "ipv4"
+	OAS_format_ipv6      = "ipv6"
+
+	// Custom formats for CIDR strings
+	OAS_format_ipv4_cidr = "ipv4-cidr"
+	OAS_format_ipv6_cidr = "ipv6-cidr"
 
 	OAS_param_path  = "path"
 	OAS_param_query = "query"
@@ -36,6 +43,12 @@ const (
 
 	OAS_mediatype_json = "application/json"
 
+	// Custom extension for strings that contain JSON
+	OAS_extension_json_string = "x-json-string"
+
+	// JSON Schema keyword that isn't available in the high-level model
+	OAS_keyword_dependent_required = "dependentRequired"
+
 	OAS_response_code_ok      = "200"
 	OAS_response_code_created = "201"
 )
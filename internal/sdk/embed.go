@@ -12,3 +12,6 @@ var ClientTemplate string
 
 //go:embed templates/refresh.go.tpl
 var RefreshTemplate string
+
+//go:embed templates/validators.go.tpl
+var ValidatorsTemplate string
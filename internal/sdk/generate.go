@@ -1,110 +1,257 @@
 package sdk
 
 import (
+	"errors"
 	"fmt"
+	"go/token"
 	"net/http"
-	"os"
+	"path"
 	"path/filepath"
+	"strconv"
+	"strings"
 
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/oas"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/pb33f/libopenapi"
 
+	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 )
 
 const (
 	VERSION = "EXPERIMENTAL"
+
+	// DefaultOutputDir and DefaultPackageName are used when the output directory or package name of the SDK isn't set
+	DefaultOutputDir   = "ncloudsdk"
+	DefaultPackageName = "ncloudsdk"
+
+	// frameworkVersion is the terraform-plugin-framework version required by the go.mod of a standalone SDK
+	frameworkVersion = "v1.13.0"
 )
 
 type ResponseDetails struct {
-	RefreshLogic                       string
-	Model                              string
-	ConvertValueWithNull               string
-	PossibleTypes                      string
-	ConvertValueWithNullInEmptyArrCase string
+	RefreshLogic string
+	Model        string
+	// AttrTypes and AttrConverters are the attribute types and converters of the response properties, as map entries
+	AttrTypes      string
+	AttrConverters string
+	// Converters are the functions converting nested objects, lists and maps of the response
+	Converters string
+	// ResponsePath is a list of quoted JSON pointer tokens, used to unwrap the response body at runtime
+	ResponsePath string
+	// MapPaths is a list of quoted JSON pointers to the maps of the response body, whose keys aren't converted at runtime
+	MapPaths string
+}
+
+// Options configures which operations the SDK is generated for.
+type Options struct {
+	// All generates the SDK for every operation of the OpenAPI document, instead of only the operations of Operations.
+	All bool
+
+	// Operations are the operations that are referenced by the generator config, i.e. the operations of the explorer resources and
+	// data sources. Other operations are skipped, so an operation that can't be generated doesn't fail the run unless it's referenced.
+	Operations []explorer.OperationLocation
+
+	// OutputDir is the directory the SDK is generated in. Defaults to DefaultOutputDir, in the current working directory.
+	OutputDir string
+
+	// PackageName is the Go package name of the SDK. Defaults to DefaultPackageName.
+	PackageName string
+
+	// ImportPath is the Go import path of the SDK, used as the module path of its go.mod.
+	ImportPath string
+
+	// GoMod emits a standalone go.mod in the output directory. Requires ImportPath.
+	GoMod bool
 }
 
-func Generate(v3Doc *libopenapi.DocumentModel[v3high.Document]) error {
-	basePath := MustAbs("./")
+// Writer writes the generated files, i.e. to a directory or in memory. Names are slash-separated paths and parent directories
+// are created by the writer.
+type Writer interface {
+	WriteFile(name string, data []byte) error
+}
+
+// withDefaults returns the options with defaults for unset fields
+func (o Options) withDefaults() (Options, error) {
+	if o.OutputDir == "" {
+		o.OutputDir = DefaultOutputDir
+	}
+	o.OutputDir = filepath.ToSlash(filepath.Clean(o.OutputDir))
+
+	if o.PackageName == "" {
+		o.PackageName = DefaultPackageName
+	}
+
+	if !token.IsIdentifier(o.PackageName) {
+		return o, fmt.Errorf("invalid package name %q - must be a valid Go package name", o.PackageName)
+	}
+
+	if o.GoMod && o.ImportPath == "" {
+		return o, errors.New("import path is required to generate a go.mod")
+	}
 
-	// Generate directories
-	err := createDirectories(basePath)
+	return o, nil
+}
+
+// includes returns true if the SDK is generated for the operation
+func (o Options) includes(method, path string) bool {
+	if o.All {
+		return true
+	}
+
+	for _, location := range o.Operations {
+		if location.Path == path && strings.EqualFold(location.Method, method) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OperationsOf returns the locations of every operation of the resources and data sources found by an explorer.
+func OperationsOf(resources map[string]explorer.Resource, dataSources map[string]explorer.DataSource) []explorer.OperationLocation {
+	var locations []explorer.OperationLocation
+
+	for _, resource := range resources {
+		locations = append(locations, resource.CreateLocation, resource.ReadLocation, resource.DeleteLocation)
+		locations = append(locations, resource.UpdateLocations...)
+	}
+
+	for _, dataSource := range dataSources {
+		locations = append(locations, dataSource.ReadLocation)
+	}
+
+	return locations
+}
+
+// Generate writes the SDK of the OpenAPI document to w, in the output directory of opts.
+func Generate(v3Doc *libopenapi.DocumentModel[v3high.Document], cfg *config.Config, opts Options, w Writer) error {
+	opts, err := opts.withDefaults()
 	if err != nil {
 		return err
 	}
 
+	basePath := opts.OutputDir
+
 	// Write down version information
-	err = writeVersionInfo(basePath)
+	err = writeVersionInfo(w, basePath)
 	if err != nil {
 		return err
 	}
 
+	// Create go.mod file, only for a standalone SDK
+	if opts.GoMod {
+		err = createGoModFile(w, basePath, opts.ImportPath)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Create client file
-	err = createClientFile(basePath)
+	err = createClientFile(w, basePath, opts.PackageName)
 	if err != nil {
 		return err
 	}
 
-	// Get a specific operation to test
-	pathItems := v3Doc.Model.Paths.PathItems.FromNewest()
+	// Create validators file
+	err = createValidatorsFile(w, basePath, opts.PackageName)
+	if err != nil {
+		return err
+	}
 
-	for key, item := range pathItems {
+	// Create security file
+	var securitySchemes *orderedmap.Map[string, *v3high.SecurityScheme]
+	if v3Doc.Model.Components != nil {
+		securitySchemes = v3Doc.Model.Components.SecuritySchemes
+	}
 
-		if err := GenerateFile(item.Get, http.MethodGet, key); err != nil {
-			return fmt.Errorf("error generating GET in key %s: %w", key, err)
-		}
+	err = createSecurityFile(w, basePath, opts.PackageName, securitySchemes)
+	if err != nil {
+		return err
+	}
 
-		if err := GenerateFile(item.Post, http.MethodPost, key); err != nil {
-			return fmt.Errorf("error generating POST in key %s: %w", key, err)
-		}
+	type operation struct {
+		method string
+		key    string
+		op     *v3high.Operation
+	}
 
-		if err := GenerateFile(item.Put, http.MethodPut, key); err != nil {
-			return fmt.Errorf("error generating PUT in key %s: %w", key, err)
+	// Get a specific operation to test
+	var operations []operation
+	for key, item := range v3Doc.Model.Paths.PathItems.FromNewest() {
+		for _, o := range []operation{
+			{http.MethodGet, key, item.Get},
+			{http.MethodPost, key, item.Post},
+			{http.MethodPut, key, item.Put},
+			{http.MethodDelete, key, item.Delete},
+			{http.MethodPatch, key, item.Patch},
+		} {
+			if o.op == nil || !opts.includes(o.method, o.key) {
+				continue
+			}
+
+			operations = append(operations, o)
 		}
+	}
+
+	// Nested request structs are shared between operations, and can't be named like the types of any method
+	requestTypes := NewRequestTypes()
+	for _, o := range operations {
+		methodName := o.method + getMethodName(o.key)
+		requestTypes.Reserve(methodName+"RequestQuery", methodName+"RequestBody", methodName+"Response")
+	}
 
-		if err := GenerateFile(item.Delete, http.MethodDelete, key); err != nil {
-			return fmt.Errorf("error generating DELETE in key %s: %w", key, err)
+	for _, o := range operations {
+		aliases, err := getAliases(cfg, o.op, o.method, o.key)
+		if err != nil {
+			return fmt.Errorf("error generating %s in key %s: %w", o.method, o.key, err)
 		}
 
-		if err := GenerateFile(item.Patch, http.MethodPatch, key); err != nil {
-			return fmt.Errorf("error generating PATCH in key %s: %w", key, err)
+		if err := GenerateFile(o.op, o.method, o.key, getResponsePath(cfg, o.op, o.method, o.key), isCreateOperation(cfg, o.op, o.method, o.key), aliases, requestTypes, v3Doc.Model.Security, opts, w); err != nil {
+			return fmt.Errorf("error generating %s in key %s: %w", o.method, o.key, err)
 		}
 	}
 
+	// Create request types file
+	err = createRequestTypesFile(w, basePath, opts.PackageName, requestTypes)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func GenerateFile(op *v3high.Operation, method, key string) error {
+func GenerateFile(op *v3high.Operation, method, key, responsePath string, noRetry bool, aliases map[string]string, requestTypes *RequestTypes, globalSecurity []*base.SecurityRequirement, opts Options, w Writer) error {
 	if op == nil {
 		return nil
 	}
 
-	f, err := os.Create(filepath.Join(MustAbs("./"), "ncloudsdk", fmt.Sprintf("%s.go", method+"_"+PathToFilename(key))))
+	refreshDetails, err := GenerateStructs(op.Responses, method+getMethodName(key), responsePath)
 	if err != nil {
 		return err
 	}
 
-	refreshDetails, err := GenerateStructs(op.Responses, method+getMethodName(key))
-	if err != nil {
-		return err
-	}
+	template := New(op, method, key, refreshDetails, aliases, requestTypes, globalSecurity, opts.PackageName)
+	template.NoRetry = noRetry
 
-	template := New(op, method, key, refreshDetails)
-
-	_, err = f.Write(template.WriteTemplate())
+	methodCode, err := template.WriteTemplate()
 	if err != nil {
 		return err
 	}
 
-	_, err = f.Write(template.WriteRefresh())
+	refreshCode, err := template.WriteRefresh()
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return w.WriteFile(path.Join(opts.OutputDir, fmt.Sprintf("%s.go", method+"_"+PathToFilename(key))), append(methodCode, refreshCode...))
 }
 
 // Generate terraform-spec type based struct with *v3high.Responses input
-func GenerateStructs(responses *v3high.Responses, responseName string) (*ResponseDetails, error) {
+func GenerateStructs(responses *v3high.Responses, responseName, responsePath string) (*ResponseDetails, error) {
 
 	// To figure out intended response code
 	codes := []string{
@@ -123,78 +270,233 @@ func GenerateStructs(responses *v3high.Responses, responseName string) (*Respons
 		// Case of http.StatusNoContent
 		if code == "204" {
 			return &ResponseDetails{
-				RefreshLogic:                       "",
-				Model:                              "",
-				ConvertValueWithNull:               "",
-				PossibleTypes:                      "",
-				ConvertValueWithNullInEmptyArrCase: "",
+				RefreshLogic:   "",
+				Model:          "",
+				AttrTypes:      "",
+				AttrConverters: "",
+				Converters:     "",
 			}, nil
 		}
 
+		if g.Content == nil {
+			return &ResponseDetails{}, nil
+		}
+
 		c, pre := g.Content.OrderedMap.Get("application/json;charset=UTF-8")
 		if !pre {
 			// Skip when expected status code does not exists.
 			// Case of empty content with 200
 			return &ResponseDetails{
-				RefreshLogic:                       "",
-				Model:                              "",
-				ConvertValueWithNull:               "",
-				PossibleTypes:                      "",
-				ConvertValueWithNullInEmptyArrCase: "",
+				RefreshLogic:   "",
+				Model:          "",
+				AttrTypes:      "",
+				AttrConverters: "",
+				Converters:     "",
 			}, nil
 		}
 
-		refreshLogic, model, convertValueWithNull, possibleTypes, convertValueWithNullInEmptyArrCase := Gen_ConvertOAStoTFTypes(c.Schema.Schema(), c.Schema.Schema().Type[0], c.Schema.Schema().Format, responseName)
+		responseSchema, err := getSchemaAtResponsePath(c.Schema, responsePath)
+		if err != nil {
+			return nil, err
+		}
+
+		refreshLogic, model, attrTypes, attrConverters, converters, err := Gen_ConvertOAStoTFTypes(responseSchema, responseName)
+		if err != nil {
+			return nil, fmt.Errorf("%s response: %w", code, err)
+		}
 
 		return &ResponseDetails{
-			RefreshLogic:                       refreshLogic,
-			Model:                              model,
-			ConvertValueWithNull:               convertValueWithNull,
-			PossibleTypes:                      possibleTypes,
-			ConvertValueWithNullInEmptyArrCase: convertValueWithNullInEmptyArrCase,
+			RefreshLogic:   refreshLogic,
+			Model:          model,
+			AttrTypes:      attrTypes,
+			AttrConverters: attrConverters,
+			Converters:     converters,
+			ResponsePath:   quoteTokens(util.JSONPointerTokens(responsePath)),
+			MapPaths:       quoteTokens(getMapPaths(c.Schema)),
 		}, nil
 	}
 
 	return nil, fmt.Errorf("no suitable responses found")
 }
 
-// Helper function to create directories
-func createDirectories(basePath string) error {
-	dirs := []string{
-		filepath.Join(basePath, "ncloudsdk"),
-		filepath.Join(basePath, "ncloudsdk", ".codegen"),
+// Helper function to find the response path of an operation, from the create and read locations in the generator config
+func getResponsePath(cfg *config.Config, op *v3high.Operation, method, path string) string {
+	if cfg == nil || op == nil {
+		return ""
 	}
 
-	for _, dir := range dirs {
-		err := os.MkdirAll(dir, os.ModePerm)
-		if err != nil {
-			return err
+	matches := func(loc *config.OpenApiSpecLocation) bool {
+		return loc != nil && loc.ResponsePath != "" && matchesLocation(loc, op, method, path)
+	}
+
+	for _, resource := range cfg.Resources {
+		if matches(resource.Create) {
+			return resource.Create.ResponsePath
+		}
+		if matches(resource.Read) {
+			return resource.Read.ResponsePath
 		}
 	}
 
-	return nil
+	for _, dataSource := range cfg.DataSources {
+		if matches(dataSource.Read) {
+			return dataSource.Read.ResponsePath
+		}
+	}
+
+	return ""
+}
+
+// Helper function to find out if an operation is the create operation of a resource in the generator config
+func isCreateOperation(cfg *config.Config, op *v3high.Operation, method, path string) bool {
+	if cfg == nil || op == nil {
+		return false
+	}
+
+	for _, resource := range cfg.Resources {
+		if resource.Create != nil && matchesLocation(resource.Create, op, method, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper function to find the parameter aliases of an operation, from the resources and data sources of the generator config that use it.
+// The attributes of aliased parameters are named after the alias, so the expanders of the operation have to read the same attributes.
+func getAliases(cfg *config.Config, op *v3high.Operation, method, path string) (map[string]string, error) {
+	aliases := map[string]string{}
+	if cfg == nil || op == nil {
+		return aliases, nil
+	}
+
+	addAliases := func(locations []*config.OpenApiSpecLocation, schemaOptions config.SchemaOptions) error {
+		for _, loc := range locations {
+			if loc == nil || !matchesLocation(loc, op, method, path) {
+				continue
+			}
+
+			for _, paramName := range util.SortedKeys(schemaOptions.AttributeOptions.Aliases) {
+				alias := schemaOptions.AttributeOptions.Aliases[paramName]
+				if existing, ok := aliases[paramName]; ok && existing != alias {
+					return fmt.Errorf("parameter '%s' is aliased as both '%s' and '%s', the expander of the operation can only read one attribute", paramName, existing, alias)
+				}
+				aliases[paramName] = alias
+			}
+		}
+
+		return nil
+	}
+
+	for _, name := range util.SortedKeys(cfg.Resources) {
+		resource := cfg.Resources[name]
+
+		locations := append([]*config.OpenApiSpecLocation{resource.Create, resource.Read, resource.Delete}, resource.Update...)
+		if err := addAliases(locations, resource.SchemaOptions); err != nil {
+			return nil, fmt.Errorf("resource '%s': %w", name, err)
+		}
+	}
+
+	for _, name := range util.SortedKeys(cfg.DataSources) {
+		dataSource := cfg.DataSources[name]
+
+		if err := addAliases([]*config.OpenApiSpecLocation{dataSource.Read}, dataSource.SchemaOptions); err != nil {
+			return nil, fmt.Errorf("data source '%s': %w", name, err)
+		}
+	}
+
+	return aliases, nil
+}
+
+// Helper function to match an operation to a generator config location, either by `operation_id` or by `path` and `method`
+func matchesLocation(loc *config.OpenApiSpecLocation, op *v3high.Operation, method, path string) bool {
+	if loc.OperationId != "" {
+		return loc.OperationId == op.OperationId
+	}
+
+	return loc.Path == path && strings.EqualFold(loc.Method, method)
+}
+
+// Helper function to descend to the subschema referenced by a response path (JSON pointer), resolving schema composition on the way
+// like the mapper, so the response converters match the attributes of the provider code spec
+func getSchemaAtResponsePath(proxy *base.SchemaProxy, responsePath string) (*base.SchemaProxy, error) {
+	proxy, err := oas.SchemaProxyAtPath(proxy, responsePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return proxy, nil
+}
+
+// Helper function to write tokens as quoted arguments in generated code
+func quoteTokens(tokens []string) string {
+	quoted := make([]string, len(tokens))
+	for i, token := range tokens {
+		quoted[i] = strconv.Quote(token)
+	}
+
+	return strings.Join(quoted, ", ")
 }
 
 // Helper function to write version information
-func writeVersionInfo(basePath string) error {
-	v, err := os.Create(filepath.Join(basePath, "ncloudsdk", ".codegen", "VERSION"))
+func writeVersionInfo(w Writer, basePath string) error {
+	return w.WriteFile(path.Join(basePath, ".codegen", "VERSION"), []byte(VERSION))
+}
+
+// Helper function to create go.mod file of a standalone SDK
+func createGoModFile(w Writer, basePath, importPath string) error {
+	return w.WriteFile(path.Join(basePath, "go.mod"), []byte(fmt.Sprintf("module %s\n\ngo 1.22\n\nrequire github.com/hashicorp/terraform-plugin-framework %s\n", importPath, frameworkVersion)))
+}
+
+// Helper function to create client file
+func createClientFile(w Writer, basePath, packageName string) error {
+	client, err := WriteClient(packageName)
 	if err != nil {
 		return err
 	}
-	defer v.Close()
 
-	_, err = v.WriteString(VERSION)
-	return err
+	return w.WriteFile(path.Join(basePath, "client.go"), client)
 }
 
-// Helper function to create client file
-func createClientFile(basePath string) error {
-	c, err := os.Create(filepath.Join(basePath, "ncloudsdk", "client.go"))
+// Helper function to create validators file
+func createValidatorsFile(w Writer, basePath, packageName string) error {
+	validators, err := WriteValidators(packageName)
 	if err != nil {
 		return err
 	}
-	defer c.Close()
 
-	_, err = c.Write(WriteClient())
-	return err
+	return w.WriteFile(path.Join(basePath, "validators.go"), validators)
+}
+
+// Helper function to create security file
+func createSecurityFile(w Writer, basePath, packageName string, securitySchemes *orderedmap.Map[string, *v3high.SecurityScheme]) error {
+	return w.WriteFile(path.Join(basePath, "security.go"), WriteSecurity(packageName, securitySchemes))
+}
+
+// Helper function to create request types file
+func createRequestTypesFile(w Writer, basePath, packageName string, requestTypes *RequestTypes) error {
+	definitions := requestTypes.Definitions()
+
+	// Expanders of the definitions use these imports, which would be unused without any definitions
+	imports := ""
+	if definitions != "" {
+		imports = `import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+`
+	}
+
+	requestTypesFile := `/* =================================================================================
+ * NCLOUD SDK LAYER FOR TERRAFORM CODEGEN - DO NOT EDIT
+ * ================================================================================= */
+
+package ` + packageName + `
+
+` + imports + definitions
+
+	return w.WriteFile(path.Join(basePath, "request_types.go"), []byte(requestTypesFile))
 }
@@ -0,0 +1,477 @@
+package sdk
+
+import (
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pb33f/libopenapi"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+func TestIsCreateOperation(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		Resources: map[string]config.Resource{
+			"server": {
+				Create: &config.OpenApiSpecLocation{
+					Path:   "/createServerInstances",
+					Method: "GET",
+				},
+				Read: &config.OpenApiSpecLocation{
+					Path:   "/getServerInstanceDetail",
+					Method: "GET",
+				},
+			},
+			"vpc": {
+				Create: &config.OpenApiSpecLocation{
+					OperationId: "createVpc",
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		cfg    *config.Config
+		op     *v3high.Operation
+		method string
+		path   string
+		want   bool
+	}{
+		"create by path and method": {
+			cfg:    cfg,
+			op:     &v3high.Operation{},
+			method: "GET",
+			path:   "/createServerInstances",
+			want:   true,
+		},
+		"create by operation id": {
+			cfg:    cfg,
+			op:     &v3high.Operation{OperationId: "createVpc"},
+			method: "POST",
+			path:   "/vpcs",
+			want:   true,
+		},
+		"read": {
+			cfg:    cfg,
+			op:     &v3high.Operation{},
+			method: "GET",
+			path:   "/getServerInstanceDetail",
+			want:   false,
+		},
+		"other method": {
+			cfg:    cfg,
+			op:     &v3high.Operation{},
+			method: "POST",
+			path:   "/createServerInstances",
+			want:   false,
+		},
+		"no config": {
+			op:     &v3high.Operation{},
+			method: "GET",
+			path:   "/createServerInstances",
+			want:   false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := isCreateOperation(testCase.cfg, testCase.op, testCase.method, testCase.path)
+
+			if got != testCase.want {
+				t.Errorf("expected %t, got %t", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestGetAliases(t *testing.T) {
+	t.Parallel()
+
+	serverLocation := &config.OpenApiSpecLocation{
+		Path:   "/getServerInstanceDetail",
+		Method: "GET",
+	}
+
+	testCases := map[string]struct {
+		cfg              *config.Config
+		path             string
+		want             map[string]string
+		expectedErrRegex string
+	}{
+		"resource and data source": {
+			cfg: &config.Config{
+				Resources: map[string]config.Resource{
+					"server": {
+						Read:          serverLocation,
+						SchemaOptions: config.SchemaOptions{AttributeOptions: config.AttributeOptions{Aliases: map[string]string{"serverInstanceNo": "id"}}},
+					},
+				},
+				DataSources: map[string]config.DataSource{
+					"server": {
+						Read:          serverLocation,
+						SchemaOptions: config.SchemaOptions{AttributeOptions: config.AttributeOptions{Aliases: map[string]string{"serverInstanceNo": "id", "regionCode": "region"}}},
+					},
+				},
+			},
+			path: "/getServerInstanceDetail",
+			want: map[string]string{"serverInstanceNo": "id", "regionCode": "region"},
+		},
+		"other operation": {
+			cfg: &config.Config{
+				Resources: map[string]config.Resource{
+					"server": {
+						Read:          serverLocation,
+						SchemaOptions: config.SchemaOptions{AttributeOptions: config.AttributeOptions{Aliases: map[string]string{"serverInstanceNo": "id"}}},
+					},
+				},
+			},
+			path: "/getServerInstanceList",
+			want: map[string]string{},
+		},
+		"conflicting aliases": {
+			cfg: &config.Config{
+				Resources: map[string]config.Resource{
+					"server": {
+						Read:          serverLocation,
+						SchemaOptions: config.SchemaOptions{AttributeOptions: config.AttributeOptions{Aliases: map[string]string{"serverInstanceNo": "id"}}},
+					},
+				},
+				DataSources: map[string]config.DataSource{
+					"server": {
+						Read:          serverLocation,
+						SchemaOptions: config.SchemaOptions{AttributeOptions: config.AttributeOptions{Aliases: map[string]string{"serverInstanceNo": "server_no"}}},
+					},
+				},
+			},
+			path:             "/getServerInstanceDetail",
+			expectedErrRegex: `data source 'server': parameter 'serverInstanceNo' is aliased as both 'id' and 'server_no'`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := getAliases(testCase.cfg, &v3high.Operation{}, "GET", testCase.path)
+
+			if testCase.expectedErrRegex != "" {
+				if err == nil || !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+					t.Fatalf("expected error to match %q, got %v", testCase.expectedErrRegex, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateStructs(t *testing.T) {
+	t.Parallel()
+
+	testOAS := `
+openapi: 3.0.1
+info:
+  title: Test
+  version: "1"
+paths:
+  /getServer:
+    get:
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json;charset=UTF-8:
+              schema:
+                allOf:
+                  - $ref: '#/components/schemas/Base'
+                  - properties:
+                      server:
+                        allOf:
+                          - $ref: '#/components/schemas/Base'
+                          - type: object
+                            properties:
+                              cpuCount:
+                                type: integer
+                                format: int32
+  /getAny:
+    get:
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json;charset=UTF-8:
+              schema:
+                description: no type
+components:
+  schemas:
+    Base:
+      type: object
+      properties:
+        requestId:
+          type: string
+`
+
+	doc, err := libopenapi.NewDocument([]byte(testOAS))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	model, errs := doc.BuildV3Model()
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	testCases := map[string]struct {
+		path              string
+		responsePath      string
+		expectedModel     []string
+		expectedConverter string
+		expectedErr       string
+	}{
+		"allOf": {
+			path: "/getServer",
+			expectedModel: []string{
+				"Server         types.Object `tfsdk:\"server\"`",
+				"Requestid         types.String `tfsdk:\"request_id\"`",
+			},
+			expectedConverter: `"cpu_count": convertInt32Value,`,
+		},
+		"allOf response path": {
+			path:         "/getServer",
+			responsePath: "/server",
+			expectedModel: []string{
+				"Cpucount         types.Int32 `tfsdk:\"cpu_count\"`",
+				"Requestid         types.String `tfsdk:\"request_id\"`",
+			},
+		},
+		"no type": {
+			path:        "/getAny",
+			expectedErr: "200 response: response schema has no type or properties",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pathItem, ok := model.Model.Paths.PathItems.Get(testCase.path)
+			if !ok {
+				t.Fatalf("expected %s in test OAS", testCase.path)
+			}
+
+			got, err := GenerateStructs(pathItem.Get.Responses, "GETServer", testCase.responsePath)
+			if testCase.expectedErr != "" {
+				if err == nil || err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %v", testCase.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, field := range testCase.expectedModel {
+				if !strings.Contains(got.Model, field) {
+					t.Errorf("expected model to contain %q, got:\n%s", field, got.Model)
+				}
+			}
+
+			if !strings.Contains(got.Converters, testCase.expectedConverter) {
+				t.Errorf("expected converters to contain %q, got:\n%s", testCase.expectedConverter, got.Converters)
+			}
+		})
+	}
+}
+
+// TestGenerate_Golden compares the SDK generated from testdata/golden with the golden files in testdata/golden/ncloudsdk, which
+// cover the request types, the response converters and the client with its error parsing.
+func TestGenerate_Golden(t *testing.T) {
+	t.Parallel()
+
+	w := memoryWriter{}
+	generateGoldenSDK(t, w)
+
+	goldenDir := filepath.Join("testdata", "golden", "ncloudsdk")
+
+	var goldenNames []string
+	err := filepath.WalkDir(goldenDir, func(name string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(goldenDir, name)
+		if err != nil {
+			return err
+		}
+
+		goldenNames = append(goldenNames, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Golden files have a .golden suffix, so they aren't picked up by Go tooling
+	var names []string
+	for name := range w {
+		names = append(names, name+".golden")
+	}
+	sort.Strings(names)
+
+	if diff := cmp.Diff(names, goldenNames); diff != "" {
+		t.Fatalf("unexpected difference in generated files: %s", diff)
+	}
+
+	for name, got := range w {
+		golden, err := os.ReadFile(filepath.Join(goldenDir, filepath.FromSlash(name)+".golden"))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if diff := cmp.Diff(string(got), string(golden)); diff != "" {
+			t.Errorf("unexpected difference in %s: %s", name, diff)
+		}
+	}
+}
+
+// TestGenerate_Compile builds the SDK generated from testdata/golden as a standalone module, and runs the tests of testdata/client
+// against it. It requires the go command and terraform-plugin-framework, either in the module cache or from the module proxy.
+func TestGenerate_Compile(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping build of the generated SDK in short mode")
+	}
+
+	goCmd, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("skipping build of the generated SDK without the go command")
+	}
+
+	dir := t.TempDir()
+	generateGoldenSDK(t, dirWriter(dir))
+
+	sdkDir := filepath.Join(dir, DefaultOutputDir)
+
+	tests, err := filepath.Glob(filepath.Join("testdata", "client", "*_test.go"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, test := range tests {
+		data, err := os.ReadFile(test)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if err := os.WriteFile(filepath.Join(sdkDir, filepath.Base(test)), data, 0o644); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	runGo(t, goCmd, sdkDir, "vet", "./...")
+	runGo(t, goCmd, sdkDir, "test", "./...")
+}
+
+// generateGoldenSDK generates the SDK of testdata/golden, as a standalone module in the default output directory
+func generateGoldenSDK(t *testing.T, w Writer) {
+	t.Helper()
+
+	spec, err := os.ReadFile(filepath.Join("testdata", "golden", "openapi_spec.yml"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cfgBytes, err := os.ReadFile(filepath.Join("testdata", "golden", "generator_config.yml"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cfg, err := config.ParseConfig(cfgBytes)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	doc, err := libopenapi.NewDocument(spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	model, errs := doc.BuildV3Model()
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	opts := Options{
+		All:        true,
+		ImportPath: "example.com/ncloudsdk",
+		GoMod:      true,
+	}
+
+	if err := Generate(model, cfg, opts, w); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+// runGo runs a go command in a generated module, failing the test with its output if the command fails
+func runGo(t *testing.T, goCmd, dir string, args ...string) {
+	t.Helper()
+
+	cmd := exec.Command(goCmd, args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOWORK=off")
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("go %v: %s\n%s", args, err, output)
+	}
+}
+
+// memoryWriter is a Writer that keeps the files in memory, by name relative to the output directory
+type memoryWriter map[string][]byte
+
+func (m memoryWriter) WriteFile(name string, data []byte) error {
+	rel, err := filepath.Rel(DefaultOutputDir, filepath.FromSlash(name))
+	if err != nil {
+		return err
+	}
+
+	m[path.Clean(filepath.ToSlash(rel))] = data
+	return nil
+}
+
+// dirWriter is a Writer for a directory on disk
+type dirWriter string
+
+func (d dirWriter) WriteFile(name string, data []byte) error {
+	filename := filepath.Join(string(d), filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, data, 0o644)
+}
@@ -0,0 +1,291 @@
+package sdk
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// RequestTypes collects the Go struct definitions of nested objects in request schemas, along with the expander functions that
+// build them from Terraform values. Objects that are defined with a `$ref` are deduplicated by the reference, so every operation
+// that uses the same schema shares a single struct.
+type RequestTypes struct {
+	definitions map[string]string
+
+	// structNames maps the reference of a schema, or the name of an inline schema, to the name of its struct
+	structNames map[string]string
+
+	// reserved holds the names that are declared by the rest of the generated package
+	reserved map[string]bool
+}
+
+// reservedNames are the exported identifiers of the client and validators files, which share a package with the request structs
+var reservedNames = []string{
+	"APIError",
+	"APIKeyAuthenticator",
+	"Authenticator",
+	"BearerTokenAuthenticator",
+	"ClearDoubleQuote",
+	"ClientOptions",
+	"Copy",
+	"CopyWithOption",
+	"FieldNameMapping",
+	"IsNotFound",
+	"MultipleOf",
+	"MultipleOfValidator",
+	"NClient",
+	"NewClient",
+	"NewNullable",
+	"Null",
+	"Nullable",
+	"NumberAtLeast",
+	"NumberAtMost",
+	"NumberBoundValidator",
+	"NumberGreaterThan",
+	"NumberLessThan",
+	"Option",
+	"SignatureV2Authenticator",
+	"TypeConverter",
+	"WithoutRetry",
+}
+
+func NewRequestTypes() *RequestTypes {
+	r := &RequestTypes{
+		definitions: map[string]string{},
+		structNames: map[string]string{},
+		reserved:    map[string]bool{},
+	}
+
+	r.Reserve(reservedNames...)
+
+	return r
+}
+
+// Reserve marks names that are declared elsewhere in the generated package, like the request and response types of each method,
+// so no request struct is given the same name.
+func (r *RequestTypes) Reserve(names ...string) {
+	for _, name := range names {
+		r.reserved[name] = true
+	}
+}
+
+// GoType returns the Go type of a request property and the expander that builds it from a Terraform value, registering a struct
+// definition for every nested object. Inline objects don't have a `$ref` to be named by, so their structs are named with the given name.
+//
+// Schema composition is resolved the same way as the provider code spec, so the struct fields match the Terraform attributes.
+//
+// The expander is Go code of a `func(context.Context, attr.Value) (T, error)`, either the name of a function or a function literal.
+func (r *RequestTypes) GoType(proxy *base.SchemaProxy, name string) (string, string) {
+	schema := resolveSchema(proxy)
+	if schema == nil {
+		return "interface{}", "expandAny"
+	}
+
+	switch getSchemaType(schema) {
+	case "string":
+		return "*string", "expandString"
+
+	case "boolean":
+		return "*bool", "expandBool"
+
+	case "integer":
+		if schema.Format == "int32" {
+			return "*int32", "expandInt32"
+		}
+		return "*int64", "expandInt64"
+
+	case "number":
+		return "*float64", "expandFloat64"
+
+	case "array":
+		elemType, elemExpander := "interface{}", "expandAny"
+		if schema.Items != nil && schema.Items.IsA() {
+			elemType, elemExpander = r.GoType(schema.Items.A, name)
+		}
+
+		return "[]" + elemType, fmt.Sprintf("func(ctx context.Context, v attr.Value) ([]%[1]s, error) { return expandList(ctx, v, %[2]s) }", elemType, elemExpander)
+
+	case "object":
+		if schema.AdditionalProperties != nil && schema.AdditionalProperties.IsA() {
+			elemType, elemExpander := r.GoType(schema.AdditionalProperties.A, name)
+
+			return "map[string]" + elemType, fmt.Sprintf("func(ctx context.Context, v attr.Value) (map[string]%[1]s, error) { return expandMap(ctx, v, %[2]s) }", elemType, elemExpander)
+		}
+
+		// Free-form objects have no properties to write as struct fields
+		if schema.Properties == nil || schema.Properties.Len() == 0 {
+			return "map[string]interface{}", "expandAnyMap"
+		}
+
+		key, structName := name, name
+		if proxy.IsReference() {
+			parts := strings.Split(proxy.GetReference(), "/")
+			key, structName = proxy.GetReference(), PathToPascal(parts[len(parts)-1])
+		}
+
+		structName = r.addStruct(key, structName, schema)
+
+		return "*" + structName, "expand_" + structName
+	}
+
+	return "interface{}", "expandAny"
+}
+
+// FieldType returns the Go type and expander of a request body field, like GoType. Fields of nullable properties are wrapped in
+// Nullable, so a null Terraform value is sent as an explicit JSON null instead of being omitted.
+func (r *RequestTypes) FieldType(proxy *base.SchemaProxy, name string) (string, string) {
+	goType, expander := r.GoType(proxy, name)
+
+	if !isNullable(resolveSchema(proxy)) {
+		return goType, expander
+	}
+
+	return "*Nullable[" + goType + "]", fmt.Sprintf("func(ctx context.Context, v attr.Value) (*Nullable[%[1]s], error) { return expandNullable(ctx, v, %[2]s) }", goType, expander)
+}
+
+// Definitions returns every registered struct definition, sorted by name.
+func (r *RequestTypes) Definitions() string {
+	names := make([]string, 0, len(r.definitions))
+	for name := range r.definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var definitions strings.Builder
+	for _, name := range names {
+		definitions.WriteString(r.definitions[name] + "\n")
+	}
+
+	return definitions.String()
+}
+
+// addStruct registers the struct of an object schema once per key, returning its name. Names that are already taken by another
+// schema, or reserved by the rest of the package, are suffixed with a number.
+func (r *RequestTypes) addStruct(key, name string, schema *base.Schema) string {
+	if structName, ok := r.structNames[key]; ok {
+		return structName
+	}
+
+	structName := name
+	for i := 2; r.isTaken(structName); i++ {
+		structName = fmt.Sprintf("%s%d", name, i)
+	}
+	name = structName
+
+	// Registered before the fields are written, so recursive schemas reference the struct instead of looping
+	r.structNames[key] = name
+	r.definitions[name] = ""
+
+	var definition strings.Builder
+	definition.WriteString(fmt.Sprintf("type %s struct {", name) + "\n")
+
+	var fields []ExpanderField
+	for key, propProxy := range schema.Properties.FromOldest() {
+		fieldName := FirstAlphabetToUpperCase(key)
+		goType, expander := r.FieldType(propProxy, name+fieldName)
+
+		definition.WriteString(fmt.Sprintf("%[1]s %[2]s `json:\"%[3]s,omitempty\"`", fieldName, goType, key) + "\n")
+		fields = append(fields, NewExpanderField(fieldName, key, expander))
+	}
+
+	definition.WriteString("}\n")
+	definition.WriteString(WriteExpander("expand_"+name, name, fields))
+
+	r.definitions[name] = definition.String()
+
+	return name
+}
+
+func (r *RequestTypes) isTaken(name string) bool {
+	if r.reserved[name] {
+		return true
+	}
+
+	_, ok := r.definitions[name]
+	return ok
+}
+
+// ExpanderField is a struct field that is built from an attribute of a Terraform object value.
+type ExpanderField struct {
+	FieldName     string
+	AttributeName string
+	Expander      string
+}
+
+// NewExpanderField returns the field of a request property, the attribute is named after the property, or the alias of a parameter,
+// the same way as the Terraform schema.
+func NewExpanderField(fieldName, propertyName, expander string) ExpanderField {
+	return ExpanderField{
+		FieldName:     fieldName,
+		AttributeName: util.TerraformIdentifier(propertyName),
+		Expander:      expander,
+	}
+}
+
+// WriteExpander returns the definition of a function that builds a request struct from a Terraform object value. A null or unknown
+// object is expanded to a nil struct, and null or unknown attributes are expanded to nil fields, so they are omitted from the request,
+// except for null attributes of nullable properties, which are sent as an explicit null.
+func WriteExpander(functionName, structName string, fields []ExpanderField) string {
+	var expander strings.Builder
+
+	expander.WriteString(fmt.Sprintf(`
+	func %[1]s(ctx context.Context, value attr.Value) (*%[2]s, error) {
+		attributes, err := expandObjectAttributes(ctx, value)
+		if err != nil || attributes == nil {
+			return nil, err
+		}
+
+		var r %[2]s`, functionName, structName) + "\n")
+
+	for _, field := range fields {
+		expander.WriteString(fmt.Sprintf(`
+		r.%[1]s, err = %[3]s(ctx, attributes["%[2]s"])
+		if err != nil {
+			return nil, fmt.Errorf("error expanding attribute %[2]s: %%w", err)
+		}`, field.FieldName, field.AttributeName, field.Expander) + "\n")
+	}
+
+	expander.WriteString(`
+		return &r, nil
+	}` + "\n")
+
+	return expander.String()
+}
+
+// Helper function to find out if a schema is nullable, either with `nullable` (OAS 3.0) or the null type (OAS 3.1)
+func isNullable(schema *base.Schema) bool {
+	if schema == nil {
+		return false
+	}
+
+	if schema.Nullable != nil && *schema.Nullable {
+		return true
+	}
+
+	for _, t := range schema.Type {
+		if t == "null" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper function to find the type of a schema, skipping the null type of nullable schemas
+func getSchemaType(schema *base.Schema) string {
+	for _, t := range schema.Type {
+		if t != "null" {
+			return t
+		}
+	}
+
+	// Properties are only valid for objects, it's possible tools might omit the type
+	if schema.Properties != nil && schema.Properties.Len() > 0 {
+		return "object"
+	}
+
+	return ""
+}
@@ -0,0 +1,139 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+func TestRequestTypes_FieldType(t *testing.T) {
+	t.Parallel()
+
+	nullable := true
+
+	testCases := map[string]struct {
+		schema           *base.Schema
+		expectedType     string
+		expectedExpander string
+	}{
+		"string": {
+			schema:           &base.Schema{Type: []string{"string"}},
+			expectedType:     "*string",
+			expectedExpander: "expandString",
+		},
+		"nullable string": {
+			schema:           &base.Schema{Type: []string{"string"}, Nullable: &nullable},
+			expectedType:     "*Nullable[*string]",
+			expectedExpander: "func(ctx context.Context, v attr.Value) (*Nullable[*string], error) { return expandNullable(ctx, v, expandString) }",
+		},
+		"null type": {
+			schema:           &base.Schema{Type: []string{"integer", "null"}},
+			expectedType:     "*Nullable[*int64]",
+			expectedExpander: "func(ctx context.Context, v attr.Value) (*Nullable[*int64], error) { return expandNullable(ctx, v, expandInt64) }",
+		},
+		"nullable array": {
+			schema: &base.Schema{
+				Type:     []string{"array"},
+				Nullable: &nullable,
+				Items:    &base.DynamicValue[*base.SchemaProxy, bool]{A: base.CreateSchemaProxy(&base.Schema{Type: []string{"boolean"}})},
+			},
+			expectedType:     "*Nullable[[]*bool]",
+			expectedExpander: "func(ctx context.Context, v attr.Value) (*Nullable[[]*bool], error) { return expandNullable(ctx, v, func(ctx context.Context, v attr.Value) ([]*bool, error) { return expandList(ctx, v, expandBool) }) }",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotType, gotExpander := NewRequestTypes().FieldType(base.CreateSchemaProxy(testCase.schema), "Field")
+
+			if diff := cmp.Diff(gotType, testCase.expectedType); diff != "" {
+				t.Errorf("unexpected difference in type: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotExpander, testCase.expectedExpander); diff != "" {
+				t.Errorf("unexpected difference in expander: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRequestTypes_GoType_structNames(t *testing.T) {
+	t.Parallel()
+
+	spec := []byte(`
+openapi: 3.0.1
+info:
+  title: example
+  version: "1"
+paths: {}
+components:
+  schemas:
+    Server:
+      type: object
+      properties:
+        name:
+          type: string
+    NClient:
+      type: object
+      properties:
+        clientName:
+          type: string
+    Body:
+      type: object
+      properties:
+        server:
+          $ref: '#/components/schemas/Server'
+        sameServer:
+          $ref: '#/components/schemas/Server'
+        client:
+          $ref: '#/components/schemas/NClient'
+        inline:
+          type: object
+          properties:
+            size:
+              type: integer
+`)
+
+	doc, err := libopenapi.NewDocument(spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	model, errs := doc.BuildV3Model()
+	if len(errs) > 0 {
+		t.Fatalf("unexpected error: %s", errors.Join(errs...))
+	}
+
+	body, _ := model.Model.Components.Schemas.Get("Body")
+	properties := body.Schema().Properties
+
+	// Struct names depend on the structs that are already registered, so the steps share the request types and run in order
+	requestTypes := NewRequestTypes()
+
+	steps := []struct {
+		property     string
+		name         string
+		expectedType string
+	}{
+		{property: "server", name: "Unused", expectedType: "*Server"},
+		{property: "sameServer", name: "Unused", expectedType: "*Server"},
+		{property: "client", name: "Unused", expectedType: "*NClient2"},
+		{property: "inline", name: "Server", expectedType: "*Server2"},
+	}
+
+	for _, step := range steps {
+		proxy, _ := properties.Get(step.property)
+		gotType, _ := requestTypes.GoType(proxy, step.name)
+
+		if diff := cmp.Diff(gotType, step.expectedType); diff != "" {
+			t.Errorf("%s: unexpected difference in type: %s", step.property, diff)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// getSecurity returns the security requirements of an operation as Go code of a [][]string, falling back to the global
+// requirements of the document. Returns "nil" if neither are defined, so the client authenticates with its default authenticator.
+func getSecurity(opSecurity, globalSecurity []*base.SecurityRequirement) string {
+	security := opSecurity
+	if security == nil {
+		security = globalSecurity
+	}
+
+	if security == nil {
+		return "nil"
+	}
+
+	var requirements []string
+	for _, requirement := range security {
+		var schemes []string
+		if requirement.Requirements != nil {
+			for name := range requirement.Requirements.KeysFromOldest() {
+				schemes = append(schemes, fmt.Sprintf("%q", name))
+			}
+		}
+
+		requirements = append(requirements, "{"+strings.Join(schemes, ", ")+"}")
+	}
+
+	return "[][]string{" + strings.Join(requirements, ", ") + "}"
+}
+
+// WriteSecurity returns the file with the default authenticator of every security scheme, by scheme name. Schemes that
+// can't be authenticated by a built-in authenticator are skipped, so they must be set in the client options.
+func WriteSecurity(packageName string, schemes *orderedmap.Map[string, *v3high.SecurityScheme]) []byte {
+	var authenticators strings.Builder
+	usesSignatureV2 := false
+
+	if schemes != nil {
+		for name, scheme := range schemes.FromOldest() {
+			authenticator := getAuthenticator(scheme)
+			if authenticator == "" {
+				continue
+			}
+
+			if authenticator == "signatureV2" {
+				usesSignatureV2 = true
+			}
+
+			authenticators.WriteString(fmt.Sprintf("%q: %s,", name, authenticator) + "\n")
+		}
+	}
+
+	// NCP API Gateway schemes share a single authenticator, as each of them is one of the headers it sets
+	signatureV2 := ""
+	if usesSignatureV2 {
+		signatureV2 = "signatureV2 := &SignatureV2Authenticator{AccessKey: accessKey, SecretKey: secretKey}\n"
+	}
+
+	return []byte(fmt.Sprintf(`/* =================================================================================
+ * NCLOUD SDK LAYER FOR TERRAFORM CODEGEN - DO NOT EDIT
+ * ================================================================================= */
+
+package %[3]s
+
+// defaultAuthenticators returns the authenticator of every security scheme of the OpenAPI document, by scheme name
+func defaultAuthenticators(accessKey, secretKey string, options ClientOptions) map[string]Authenticator {
+	%[1]s
+	return map[string]Authenticator{
+		%[2]s
+	}
+}
+`, signatureV2, authenticators.String(), packageName))
+}
+
+// Helper function to find the built-in authenticator of a security scheme, as Go code
+func getAuthenticator(scheme *v3high.SecurityScheme) string {
+	if scheme == nil {
+		return ""
+	}
+
+	switch scheme.Type {
+	case "apiKey":
+		// Headers of NCP API Gateway, i.e. x-ncp-iam-access-key, are set by signing the request
+		if strings.HasPrefix(strings.ToLower(scheme.Name), "x-ncp-") {
+			return "signatureV2"
+		}
+
+		return fmt.Sprintf("&APIKeyAuthenticator{Name: %q, In: %q, Value: options.APIKey}", scheme.Name, scheme.In)
+
+	case "http":
+		if strings.EqualFold(scheme.Scheme, "bearer") {
+			return "&BearerTokenAuthenticator{Token: options.BearerToken}"
+		}
+
+	// Tokens of OAuth 2.0 and OpenID Connect are sent as bearer tokens, obtaining them is up to the provider
+	case "oauth2", "openIdConnect":
+		return "&BearerTokenAuthenticator{Token: options.BearerToken}"
+	}
+
+	return ""
+}
@@ -0,0 +1,165 @@
+package sdk
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+func TestGetSecurity(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opSecurity     []*base.SecurityRequirement
+		globalSecurity []*base.SecurityRequirement
+		want           string
+	}{
+		"undefined": {
+			want: "nil",
+		},
+		"global": {
+			globalSecurity: []*base.SecurityRequirement{
+				securityRequirement("accessKey", "signature"),
+			},
+			want: `[][]string{{"accessKey", "signature"}}`,
+		},
+		"operation overrides global": {
+			opSecurity: []*base.SecurityRequirement{
+				securityRequirement("bearer"),
+				securityRequirement("apiKey"),
+			},
+			globalSecurity: []*base.SecurityRequirement{
+				securityRequirement("accessKey", "signature"),
+			},
+			want: `[][]string{{"bearer"}, {"apiKey"}}`,
+		},
+		"operation without security": {
+			opSecurity: []*base.SecurityRequirement{},
+			globalSecurity: []*base.SecurityRequirement{
+				securityRequirement("accessKey"),
+			},
+			want: `[][]string{}`,
+		},
+		"optional security": {
+			opSecurity: []*base.SecurityRequirement{
+				securityRequirement("bearer"),
+				{},
+			},
+			want: `[][]string{{"bearer"}, {}}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getSecurity(testCase.opSecurity, testCase.globalSecurity)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		scheme *v3high.SecurityScheme
+		want   string
+	}{
+		"nil": {
+			want: "",
+		},
+		"ncp api gateway header": {
+			scheme: &v3high.SecurityScheme{Type: "apiKey", Name: "x-ncp-iam-access-key", In: "header"},
+			want:   "signatureV2",
+		},
+		"api key header": {
+			scheme: &v3high.SecurityScheme{Type: "apiKey", Name: "X-API-Key", In: "header"},
+			want:   `&APIKeyAuthenticator{Name: "X-API-Key", In: "header", Value: options.APIKey}`,
+		},
+		"api key query": {
+			scheme: &v3high.SecurityScheme{Type: "apiKey", Name: "api_key", In: "query"},
+			want:   `&APIKeyAuthenticator{Name: "api_key", In: "query", Value: options.APIKey}`,
+		},
+		"http bearer": {
+			scheme: &v3high.SecurityScheme{Type: "http", Scheme: "Bearer"},
+			want:   "&BearerTokenAuthenticator{Token: options.BearerToken}",
+		},
+		"http basic": {
+			scheme: &v3high.SecurityScheme{Type: "http", Scheme: "basic"},
+			want:   "",
+		},
+		"oauth2": {
+			scheme: &v3high.SecurityScheme{Type: "oauth2"},
+			want:   "&BearerTokenAuthenticator{Token: options.BearerToken}",
+		},
+		"openIdConnect": {
+			scheme: &v3high.SecurityScheme{Type: "openIdConnect"},
+			want:   "&BearerTokenAuthenticator{Token: options.BearerToken}",
+		},
+		"mutualTLS": {
+			scheme: &v3high.SecurityScheme{Type: "mutualTLS"},
+			want:   "",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getAuthenticator(testCase.scheme)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteSecurity(t *testing.T) {
+	t.Parallel()
+
+	schemes := orderedmap.New[string, *v3high.SecurityScheme]()
+	schemes.Set("accessKey", &v3high.SecurityScheme{Type: "apiKey", Name: "x-ncp-iam-access-key", In: "header"})
+	schemes.Set("signature", &v3high.SecurityScheme{Type: "apiKey", Name: "x-ncp-apigw-signature-v2", In: "header"})
+	schemes.Set("basic", &v3high.SecurityScheme{Type: "http", Scheme: "basic"})
+	schemes.Set("bearer", &v3high.SecurityScheme{Type: "http", Scheme: "bearer"})
+
+	got := string(WriteSecurity("ncloudsdk", schemes))
+
+	for _, want := range []string{
+		"package ncloudsdk",
+		"signatureV2 := &SignatureV2Authenticator{AccessKey: accessKey, SecretKey: secretKey}",
+		`"accessKey": signatureV2,`,
+		`"signature": signatureV2,`,
+		`"bearer": &BearerTokenAuthenticator{Token: options.BearerToken},`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected generated security file to contain %q, got:\n%s", want, got)
+		}
+	}
+
+	if strings.Contains(got, `"basic"`) {
+		t.Errorf("expected scheme without a built-in authenticator to be skipped, got:\n%s", got)
+	}
+}
+
+func securityRequirement(schemes ...string) *base.SecurityRequirement {
+	requirements := orderedmap.New[string, []string]()
+	for _, scheme := range schemes {
+		requirements.Set(scheme, []string{})
+	}
+
+	return &base.SecurityRequirement{Requirements: requirements}
+}
@@ -3,37 +3,45 @@ package sdk
 import (
 	"bytes"
 	"fmt"
-	"log"
-	"path/filepath"
 	"strings"
 	"text/template"
 
+	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
 )
 
 type Template struct {
-	OAS                                *v3high.Operation
-	funcMap                            template.FuncMap
-	methodName                         string
-	method                             string
-	model                              string
-	path                               string
-	requestQueryParameters             string
-	requestBodyParameters              string
-	functionName                       string
-	refreshLogic                       string
-	possibleTypes                      string
-	conditionalObjectFieldsWithNull    string
-	convertValueWithNullInEmptyArrCase string
-	query                              string
-	body                               string
+	OAS                    *v3high.Operation
+	packageName            string
+	funcMap                template.FuncMap
+	methodName             string
+	method                 string
+	model                  string
+	path                   string
+	security               string
+	requestQueryParameters string
+	requestBodyParameters  string
+	expanders              string
+	functionName           string
+	refreshLogic           string
+	attrTypes              string
+	attrConverters         string
+	converters             string
+	responsePath           string
+	mapPaths               string
+	query                  string
+	body                   string
+
+	// NoRetry disables retries of the generated method, for operations that create resources
+	NoRetry bool
 }
 
-func New(oas *v3high.Operation, method, path string, refreshDetails *ResponseDetails) *Template {
+func New(oas *v3high.Operation, method, path string, refreshDetails *ResponseDetails, aliases map[string]string, requestTypes *RequestTypes, globalSecurity []*base.SecurityRequirement, packageName string) *Template {
 
 	t := &Template{
-		OAS:    oas,
-		method: method,
+		OAS:         oas,
+		packageName: packageName,
+		method:      method,
 	}
 
 	funcMap := CreateFuncMap()
@@ -42,106 +50,143 @@ func New(oas *v3high.Operation, method, path string, refreshDetails *ResponseDet
 	t.model = refreshDetails.Model
 	t.refreshLogic = refreshDetails.RefreshLogic
 	t.path = getPath(path)
+	t.security = getSecurity(oas.Security, globalSecurity)
 
-	requestQueryParameters, initQuery := getQueryParameters(oas.Parameters, t.methodName)
-	requestBodyParameters, initBody := getBodyParameters(oas.RequestBody, t.methodName)
+	requestQueryParameters, initQuery, queryExpander := getQueryParameters(oas.Parameters, t.methodName, aliases, requestTypes)
+	requestBodyParameters, initBody, bodyExpander := getBodyParameters(oas.RequestBody, t.methodName, requestTypes)
 	t.requestQueryParameters = requestQueryParameters
 	t.requestBodyParameters = requestBodyParameters
+	t.expanders = queryExpander + bodyExpander
 	t.query = initQuery
 	t.body = initBody
 
 	t.functionName = getFunctionName(t.methodName, requestQueryParameters, requestBodyParameters)
 
 	t.funcMap = funcMap
-	t.possibleTypes = refreshDetails.PossibleTypes
-	t.conditionalObjectFieldsWithNull = refreshDetails.ConvertValueWithNull
-	t.convertValueWithNullInEmptyArrCase = refreshDetails.ConvertValueWithNullInEmptyArrCase
+	t.attrTypes = refreshDetails.AttrTypes
+	t.attrConverters = refreshDetails.AttrConverters
+	t.converters = refreshDetails.Converters
+	t.responsePath = refreshDetails.ResponsePath
+	t.mapPaths = refreshDetails.MapPaths
 
 	return t
 }
 
-func WriteClient() []byte {
+func WriteClient(packageName string) ([]byte, error) {
 	var b bytes.Buffer
 
 	clientTemplate, err := template.New("").Parse(ClientTemplate)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering create: %v", err)
+		return nil, fmt.Errorf("error parsing template: %w", err)
 	}
 
-	err = clientTemplate.ExecuteTemplate(&b, "Client", nil)
+	err = clientTemplate.ExecuteTemplate(&b, "Client", struct{ PackageName string }{PackageName: packageName})
 	if err != nil {
-		log.Fatalf("error occurred with Generating Method: %v", err)
+		return nil, fmt.Errorf("error generating method: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
-func (t *Template) WriteRefresh() []byte {
+func WriteValidators(packageName string) ([]byte, error) {
+	var b bytes.Buffer
+
+	validatorsTemplate, err := template.New("").Parse(ValidatorsTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %w", err)
+	}
+
+	err = validatorsTemplate.ExecuteTemplate(&b, "Validators", struct{ PackageName string }{PackageName: packageName})
+	if err != nil {
+		return nil, fmt.Errorf("error generating validators: %w", err)
+	}
+
+	return b.Bytes(), nil
+}
+
+func (t *Template) WriteRefresh() ([]byte, error) {
 	var b bytes.Buffer
 
 	refreshTemplate, err := template.New("").Funcs(t.funcMap).Parse(RefreshTemplate)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering create: %v", err)
+		return nil, fmt.Errorf("error parsing template: %w", err)
 	}
 
 	data := struct {
-		MethodName                         string
-		Model                              string
-		RefreshLogic                       string
-		PossibleTypes                      string
-		ConditionalObjectFieldsWithNull    string
-		ConvertValueWithNullInEmptyArrCase string
+		MethodName     string
+		Model          string
+		RefreshLogic   string
+		AttrTypes      string
+		AttrConverters string
+		Converters     string
+		ResponsePath   string
 	}{
-		MethodName:                         t.methodName,
-		Model:                              t.model,
-		RefreshLogic:                       t.refreshLogic,
-		PossibleTypes:                      t.possibleTypes,
-		ConditionalObjectFieldsWithNull:    t.conditionalObjectFieldsWithNull,
-		ConvertValueWithNullInEmptyArrCase: t.convertValueWithNullInEmptyArrCase,
+		MethodName:     t.methodName,
+		Model:          t.model,
+		RefreshLogic:   t.refreshLogic,
+		AttrTypes:      t.attrTypes,
+		AttrConverters: t.attrConverters,
+		Converters:     t.converters,
+		ResponsePath:   t.responsePath,
 	}
 
 	err = refreshTemplate.ExecuteTemplate(&b, "Refresh", data)
 	if err != nil {
-		log.Fatalf("error occurred with Generating Refresh: %v", err)
+		return nil, fmt.Errorf("error generating refresh: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
-func (t *Template) WriteTemplate() []byte {
+func (t *Template) WriteTemplate() ([]byte, error) {
 	var b bytes.Buffer
 
 	methodTemplate, err := template.New("").Funcs(t.funcMap).Parse(MethodTemplate)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering create: %v", err)
+		return nil, fmt.Errorf("error parsing template: %w", err)
 	}
 
+	// The request body is marshalled with encoding/json, which would be an unused import without a body
+	hasBody := t.requestBodyParameters != ""
+
 	data := struct {
+		PackageName            string
 		MethodName             string
 		RequestQueryParameters string
 		RequestBodyParameters  string
+		Expanders              string
 		FunctionName           string
 		Query                  string
 		Body                   string
 		Path                   string
 		Method                 string
+		Security               string
+		MapPaths               string
+		NoRetry                bool
+		HasBody                bool
 	}{
+		PackageName:            t.packageName,
 		MethodName:             t.methodName,
 		Method:                 t.method,
 		RequestQueryParameters: t.requestQueryParameters,
 		RequestBodyParameters:  t.requestBodyParameters,
+		Expanders:              t.expanders,
 		FunctionName:           t.functionName,
 		Query:                  t.query,
 		Body:                   t.body,
 		Path:                   t.path,
+		Security:               t.security,
+		MapPaths:               t.mapPaths,
+		NoRetry:                t.NoRetry,
+		HasBody:                hasBody,
 	}
 
 	err = methodTemplate.ExecuteTemplate(&b, "Method", data)
 	if err != nil {
-		log.Fatalf("error occurred with Generating Method: %v", err)
+		return nil, fmt.Errorf("error generating method: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
 func getMethodName(s string) string {
@@ -185,19 +230,22 @@ func getPath(path string) string {
 		if start == -1 {
 			s = s + fmt.Sprintf(`"%s"`, val)
 		} else {
-			s = s + fmt.Sprintf(`ClearDoubleQuote(*q.%s)`, PathToPascal(val))
+			s = s + fmt.Sprintf(`ClearDoubleQuote(queryValue(q.%s))`, PathToPascal(val))
 		}
 	}
 
 	return s
 }
 
-func getQueryParameters(params []*v3high.Parameter, methodName string) (string, string) {
+// getQueryParameters writes the request struct of the path and query parameters of an operation. Aliased parameters are mapped to
+// attributes named after the alias, so their expanders read the aliased attribute.
+func getQueryParameters(params []*v3high.Parameter, methodName string, aliases map[string]string, requestTypes *RequestTypes) (string, string, string) {
 	var requestParameters strings.Builder
 	var initQuery strings.Builder
+	var fields []ExpanderField
 
 	if params == nil {
-		return "", ""
+		return "", "", ""
 	}
 
 	requestParameters.WriteString(fmt.Sprintf("type %sRequestQuery struct {", methodName) + "\n")
@@ -206,110 +254,93 @@ func getQueryParameters(params []*v3high.Parameter, methodName string) (string,
 		key := params.Name
 
 		// In Default, all parameters needs to be in request struct
-		switch params.Schema.Schema().Type[0] {
-		case "string":
-			requestParameters.WriteString(fmt.Sprintf("%[1]s *string `json:\"%[2]s,omitempty\"`", PathToPascal(key), key) + "\n")
-
-		case "boolean":
-			requestParameters.WriteString(fmt.Sprintf("%[1]s *bool `json:\"%[2]s,omitempty\"`", PathToPascal(key), key) + "\n")
-
-		case "integer":
-			if params.Schema.Schema().Format == "int64" {
-				requestParameters.WriteString(fmt.Sprintf("%[1]s *int64 `json:\"%[2]s,omitempty\"`", PathToPascal(key), key) + "\n")
-			} else if params.Schema.Schema().Format == "int32" {
-				requestParameters.WriteString(fmt.Sprintf("%[1]s *int32 `json:\"%[2]s,omitempty\"`", PathToPascal(key), key) + "\n")
-			}
+		fieldName := PathToPascal(key)
+		goType, expander := requestTypes.GoType(params.Schema, methodName+"RequestQuery"+fieldName)
+		requestParameters.WriteString(fmt.Sprintf("%[1]s %[2]s `json:\"%[3]s,omitempty\"`", fieldName, goType, key) + "\n")
 
-		case "number":
-			requestParameters.WriteString(fmt.Sprintf("%[1]s *float64 `json:\"%[2]s,omitempty\"`", PathToPascal(key), key) + "\n")
-
-		case "array":
-			requestParameters.WriteString(fmt.Sprintf("%[1]s []*string `json:\"%[2]s,omitempty\"`", PathToPascal(key), key) + "\n")
-
-		case "object":
-			requestParameters.WriteString(fmt.Sprintf("%[1]s []*string `json:\"%[2]s,omitempty\"`", PathToPascal(key), key) + "\n")
+		attributeName := key
+		if alias, ok := aliases[key]; ok {
+			attributeName = alias
 		}
+		fields = append(fields, NewExpanderField(fieldName, attributeName, expander))
 
-		// In case of query parameters
+		// In case of query parameters, values of any type are formatted as strings by queryValue
 		if params.In == "query" {
 			if params.Required == nil {
 				// optional query parameters
 				initQuery.WriteString(fmt.Sprintf(`
-				if q.%[1]s!= nil {
-					query["%[2]s"] = *q.%[1]s
-				}`, FirstAlphabetToUpperCase(key), key) + "\n")
+				if q.%[1]s != nil {
+					query["%[2]s"] = queryValue(q.%[1]s)
+				}`, fieldName, key) + "\n")
 			} else {
 				// required query parameters
 				initQuery.WriteString(fmt.Sprintf(`
-				query["%[1]s"] = *q.%[2]s`, key, FirstAlphabetToUpperCase(key)) + "\n")
+				query["%[1]s"] = queryValue(q.%[2]s)`, key, fieldName) + "\n")
 			}
 		}
 	}
 
 	requestParameters.WriteString(fmt.Sprintf("}") + "\n")
 
-	return requestParameters.String(), initQuery.String()
+	expander := WriteExpander("Expand_"+methodName+"RequestQuery", methodName+"RequestQuery", fields)
+
+	return requestParameters.String(), initQuery.String(), expander
 }
 
-func getBodyParameters(body *v3high.RequestBody, methodName string) (string, string) {
+func getBodyParameters(body *v3high.RequestBody, methodName string, requestTypes *RequestTypes) (string, string, string) {
 	var requestParameters strings.Builder
 	var initBody strings.Builder
+	var fields []ExpanderField
 
 	// return if requestBody does not needed.
 	if body == nil {
-		return "", "var body string"
+		return "", "var body string", ""
 	}
 
 	content, ok := body.Content.OrderedMap.Get("application/json;charset=UTF-8")
 	if !ok {
-		return "", "var body string"
+		return "", "var body string", ""
+	}
+
+	// Resolved like the nested request properties, so an allOf body has the merged properties
+	schema := resolveSchema(content.Schema)
+	if schema == nil {
+		return "", "var body string", ""
 	}
 
-	schema := content.Schema.Schema()
 	keys := schema.Properties.KeysFromNewest()
 
 	initBody.WriteString("rawBody, err := json.Marshal(b)" + "\n")
 	initBody.WriteString("if err != nil {" + "\n")
 	initBody.WriteString("	return nil, err" + "\n")
 	initBody.WriteString("}" + "\n")
-	initBody.WriteString("body := strings.Replace(string(rawBody), `\\\"`, \"\", -1)" + "\n")
+	initBody.WriteString("body := string(rawBody)" + "\n")
 
 	requestParameters.WriteString(fmt.Sprintf("type %sRequestBody struct {", methodName) + "\n")
 
 	for key := range keys {
 		schemaValue, ok := schema.Properties.Get(key)
 		if !ok {
-			return requestParameters.String(), initBody.String()
+			return requestParameters.String(), initBody.String(), ""
 		}
 
-		switch schemaValue.Schema().Type[0] {
-		case "string":
-			requestParameters.WriteString(fmt.Sprintf("%[1]s *string `json:\"%[2]s,omitempty\"`", FirstAlphabetToUpperCase(key), key) + "\n")
-		case "boolean":
-			requestParameters.WriteString(fmt.Sprintf("%[1]s *bool `json:\"%[2]s,omitempty\"`", FirstAlphabetToUpperCase(key), key) + "\n")
-		case "integer":
-			if schemaValue.Schema().Format == "int64" {
-				requestParameters.WriteString(fmt.Sprintf("%[1]s *int64 `json:\"%[2]s,omitempty\"`", FirstAlphabetToUpperCase(key), key) + "\n")
-			} else if schemaValue.Schema().Format == "int32" {
-				requestParameters.WriteString(fmt.Sprintf("%[1]s *int32 `json:\"%[2]s,omitempty\"`", FirstAlphabetToUpperCase(key), key) + "\n")
-			}
-		case "number":
-			requestParameters.WriteString(fmt.Sprintf("%[1]s *float64 `json:\"%[2]s,omitempty\"`", FirstAlphabetToUpperCase(key), key) + "\n")
-		case "array":
-			requestParameters.WriteString(fmt.Sprintf("%[1]s []*string `json:\"%[2]s,omitempty\"`", FirstAlphabetToUpperCase(key), key) + "\n")
-		case "object":
-			requestParameters.WriteString(fmt.Sprintf("%[1]s []*string `json:\"%[2]s,omitempty\"`", FirstAlphabetToUpperCase(key), key) + "\n")
-		}
+		goType, expander := requestTypes.FieldType(schemaValue, methodName+"RequestBody"+FirstAlphabetToUpperCase(key))
+		requestParameters.WriteString(fmt.Sprintf("%[1]s %[2]s `json:\"%[3]s,omitempty\"`", FirstAlphabetToUpperCase(key), goType, key) + "\n")
+		fields = append(fields, NewExpanderField(FirstAlphabetToUpperCase(key), key, expander))
 	}
 	requestParameters.WriteString(fmt.Sprintf("}") + "\n")
 
-	return requestParameters.String(), initBody.String()
+	expander := WriteExpander("Expand_"+methodName+"RequestBody", methodName+"RequestBody", fields)
+
+	return requestParameters.String(), initBody.String(), expander
 }
 
 func getFunctionName(methodName string, queryParameters string, bodyParameters string) string {
 	var functionName string
 
-	if len(queryParameters) <= 0 {
+	if len(queryParameters) <= 0 && len(bodyParameters) <= 0 {
+		functionName = fmt.Sprintf("func (n *NClient) %[1]s(ctx context.Context) (map[string]interface{}, error) {\n", methodName)
+	} else if len(queryParameters) <= 0 {
 		functionName = fmt.Sprintf("func (n *NClient) %[1]s(ctx context.Context, b *%[1]sRequestBody) (map[string]interface{}, error) {\n", methodName)
 	} else if len(bodyParameters) <= 0 {
 		functionName = fmt.Sprintf("func (n *NClient) %[1]s(ctx context.Context, q *%[1]sRequestQuery) (map[string]interface{}, error) {\n", methodName)
@@ -319,11 +350,3 @@ func getFunctionName(methodName string, queryParameters string, bodyParameters s
 
 	return functionName
 }
-
-func MustAbs(path string) string {
-	absPath, err := filepath.Abs(path)
-	if err != nil {
-		log.Fatalf("Error getting absolute path for %s: %v", path, err)
-	}
-	return absPath
-}
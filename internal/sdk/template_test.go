@@ -0,0 +1,100 @@
+package sdk
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+func TestWriteTemplate_NoRetry(t *testing.T) {
+	t.Parallel()
+
+	for _, noRetry := range []bool{true, false} {
+		template := New(&v3high.Operation{}, "GET", "/createServerInstances", &ResponseDetails{}, nil, NewRequestTypes(), nil, DefaultPackageName)
+		template.NoRetry = noRetry
+
+		got, err := template.WriteTemplate()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if strings.Contains(string(got), "ctx = WithoutRetry(ctx)") != noRetry {
+			t.Errorf("expected retries to be disabled: %t, got:\n%s", noRetry, got)
+		}
+	}
+}
+
+func TestWriteTemplate_Body(t *testing.T) {
+	t.Parallel()
+
+	properties := orderedmap.New[string, *base.SchemaProxy]()
+	properties.Set("name", base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}}))
+
+	content := orderedmap.New[string, *v3high.MediaType]()
+	content.Set("application/json;charset=UTF-8", &v3high.MediaType{
+		Schema: base.CreateSchemaProxy(&base.Schema{Type: []string{"object"}, Properties: properties}),
+	})
+
+	op := &v3high.Operation{
+		RequestBody: &v3high.RequestBody{Content: content},
+	}
+
+	got, err := New(op, "POST", "/things", &ResponseDetails{}, nil, NewRequestTypes(), nil, DefaultPackageName).WriteTemplate()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The marshalled body is sent unchanged, escaped quotes in values must not be stripped
+	if !strings.Contains(string(got), "body := string(rawBody)") || strings.Contains(string(got), "strings.Replace") {
+		t.Errorf("expected the marshalled body to be sent unchanged, got:\n%s", got)
+	}
+}
+
+func TestWriteTemplate_NoParameters(t *testing.T) {
+	t.Parallel()
+
+	got, err := New(&v3high.Operation{}, "GET", "/getRegionList", &ResponseDetails{}, nil, NewRequestTypes(), nil, DefaultPackageName).WriteTemplate()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Unused imports and request types don't compile, so operations without parameters take neither
+	if strings.Contains(string(got), `"encoding/json"`) {
+		t.Errorf("expected no encoding/json import without a request body, got:\n%s", got)
+	}
+
+	if !strings.Contains(string(got), "func (n *NClient) GETGetRegionList(ctx context.Context) (map[string]interface{}, error) {") {
+		t.Errorf("expected a method without parameters, got:\n%s", got)
+	}
+}
+
+func TestWriteTemplate_Aliases(t *testing.T) {
+	t.Parallel()
+
+	required := true
+	op := &v3high.Operation{
+		Parameters: []*v3high.Parameter{
+			{Name: "serverInstanceNo", In: "query", Required: &required, Schema: base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}})},
+			{Name: "regionCode", In: "query", Schema: base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}})},
+		},
+	}
+
+	got, err := New(op, "GET", "/getServerInstanceDetail", &ResponseDetails{}, map[string]string{"serverInstanceNo": "id"}, NewRequestTypes(), nil, DefaultPackageName).WriteTemplate()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Aliased parameters are read from the attribute of the alias, the others from the attribute of the parameter
+	for _, expected := range []string{
+		`r.ServerInstanceNo, err = expandString(ctx, attributes["id"])`,
+		`r.RegionCode, err = expandString(ctx, attributes["region_code"])`,
+		`query["serverInstanceNo"] = queryValue(q.ServerInstanceNo)`,
+	} {
+		if !strings.Contains(string(got), expected) {
+			t.Errorf("expected %q, got:\n%s", expected, got)
+		}
+	}
+}
@@ -3,7 +3,7 @@
  * NCLOUD SDK LAYER FOR TERRAFORM CODEGEN - DO NOT EDIT
  * ================================================================================= */
 
-package ncloudsdk
+package {{.PackageName}}
 
 import (
 	"bytes"
@@ -16,15 +16,22 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 	"unicode"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 type NClient struct {
@@ -32,6 +39,10 @@ type NClient struct {
 	HTTPClient *http.Client
 	AccessKey  string
 	SecretKey  string
+
+	options        ClientOptions
+	limiter        *rateLimiter
+	authenticators map[string]Authenticator
 }
 
 var (
@@ -42,44 +53,195 @@ var (
 	ErrFieldNameTagStartNotUpperCase = errors.New("copier field name tag must be start upper case")
 	ErrInvalidEndpoint               = errors.New("invalid endpoint URL")
 	ErrAPIRequest                    = errors.New("API request failed")
+	ErrNoAuthenticator               = errors.New("no authenticator for the security requirements")
+)
+
+const (
+	DefaultMaxRetries = 3
+	DefaultMinBackoff = 1 * time.Second
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+var (
+	// DefaultRetryableMethods are the idempotent methods, which are safe to send again. Operations that create resources are never
+	// retried, even with one of these methods, as RPC-style APIs create resources with GET requests.
+	DefaultRetryableMethods = []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete}
+
+	// DefaultRetryableStatusCodes are the status codes of rate limited and transient gateway failures
+	DefaultRetryableStatusCodes = []int{
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
 )
 
-func NewClient(baseURL, accessKey, secretKey string) *NClient {
+// ClientOptions configures the retry policy and the rate limiter of NClient. Zero values are replaced by the defaults.
+type ClientOptions struct {
+	// HTTPClient is used to make requests, a new http.Client if nil
+	HTTPClient *http.Client
+
+	// MaxRetries is the number of times a failed request is retried, DefaultMaxRetries if zero. A negative value disables retries.
+	MaxRetries int
+
+	// MinBackoff and MaxBackoff bound the exponential backoff between retries, which is jittered to spread out concurrent requests.
+	// A Retry-After header of the response takes precedence, up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// RetryableMethods are the methods of requests that are retried, DefaultRetryableMethods if nil
+	RetryableMethods []string
+
+	// RetryableStatusCodes are the status codes of responses that are retried, DefaultRetryableStatusCodes if nil.
+	// Transport errors are always retried.
+	RetryableStatusCodes []int
+
+	// RequestsPerSecond limits the rate of requests with a token bucket, unlimited if zero.
+	// Burst is the size of the bucket, 1 if zero.
+	RequestsPerSecond float64
+	Burst             int
+
+	// Authenticator authenticates requests of operations without security requirements,
+	// NCP API Gateway signature v2 with the access key and secret key of the client if nil.
+	Authenticator Authenticator
+
+	// Authenticators override the authenticators of the security schemes of the OpenAPI document, by scheme name.
+	// By default, schemes are authenticated with the credentials below, or the keys of the client for NCP API Gateway schemes.
+	Authenticators map[string]Authenticator
+
+	// BearerToken and APIKey are the credentials of the default bearer token and API key authenticators
+	BearerToken string
+	APIKey      string
+}
+
+// NewClient returns a client of the NCP API, configured with the first options if any are given.
+func NewClient(baseURL, accessKey, secretKey string, opts ...ClientOptions) *NClient {
+	var options ClientOptions
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+
+	if options.HTTPClient == nil {
+		options.HTTPClient = &http.Client{}
+	}
+	if options.MaxRetries == 0 {
+		options.MaxRetries = DefaultMaxRetries
+	}
+	if options.MinBackoff == 0 {
+		options.MinBackoff = DefaultMinBackoff
+	}
+	if options.MaxBackoff == 0 {
+		options.MaxBackoff = DefaultMaxBackoff
+	}
+	if options.RetryableMethods == nil {
+		options.RetryableMethods = DefaultRetryableMethods
+	}
+	if options.RetryableStatusCodes == nil {
+		options.RetryableStatusCodes = DefaultRetryableStatusCodes
+	}
+
+	authenticators := defaultAuthenticators(accessKey, secretKey, options)
+	for name, authenticator := range options.Authenticators {
+		authenticators[name] = authenticator
+	}
+
 	return &NClient{
-		BaseURL:    baseURL,
-		HTTPClient: &http.Client{},
-		AccessKey:  accessKey,
-		SecretKey:  secretKey,
+		BaseURL:        baseURL,
+		HTTPClient:     options.HTTPClient,
+		AccessKey:      accessKey,
+		SecretKey:      secretKey,
+		options:        options,
+		limiter:        newRateLimiter(options.RequestsPerSecond, options.Burst),
+		authenticators: authenticators,
 	}
 }
 
 // MakeRequestWithContext() - Streamlined core logic of abstracted api call
 //
-// Manufacture main request call
+// Manufacture main request call, authenticated with the default authenticator of the client
 func (n *NClient) MakeRequestWithContext(ctx context.Context, method, endpoint, reqBody string, query map[string]string) (map[string]interface{}, error) {
+	return n.MakeRequestWithSecurity(ctx, nil, method, endpoint, reqBody, query)
+}
+
+// MakeRequestWithSecurity() - Manufacture main request call of an operation with security requirements
+//
+// Security requirements are alternatives of security scheme names, every scheme of one of the alternatives is required.
+// Requests with nil requirements are authenticated with the default authenticator, and with none for empty requirements.
+// The request is retried with the retry policy of the client options.
+func (n *NClient) MakeRequestWithSecurity(ctx context.Context, security [][]string, method, endpoint, reqBody string, query map[string]string) (map[string]interface{}, error) {
 	url, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrInvalidEndpoint, err)
 	}
 
-	req, err := n.SetRequest(url, query, reqBody, method)
+	authenticators, err := n.authenticatorsFor(security)
 	if err != nil {
 		return nil, err
 	}
 
-	req = req.WithContext(ctx)
+	for attempt := 0; ; attempt++ {
+		if n.limiter != nil {
+			if err := n.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		// Query parameters are added to the URL, so every attempt starts from a copy
+		attemptURL := *url
 
-	// Make signature & set headers
-	n.SetHeader(req, url, method)
+		req, err := n.SetRequest(&attemptURL, query, reqBody, method)
+		if err != nil {
+			return nil, err
+		}
 
-	// Execute api call
-	resp, err := n.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
+		req = req.WithContext(ctx)
+
+		// Set headers & authenticate, which signs the request every attempt
+		setCommonHeaders(req)
+		for _, authenticator := range authenticators {
+			if err := authenticator.Authenticate(req); err != nil {
+				return nil, fmt.Errorf("error authenticating request: %w", err)
+			}
+		}
+
+		// Execute api call
+		resp, err := n.HTTPClient.Do(req)
+		if err != nil {
+			if ctx.Err() != nil || !n.shouldRetry(ctx, method, attempt, 0) {
+				return nil, err
+			}
+
+			if err := sleepWithContext(ctx, n.backoff(attempt, nil)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices || !n.shouldRetry(ctx, method, attempt, resp.StatusCode) {
+			return n.readResponse(req, resp)
+		}
+
+		delay := n.backoff(attempt, resp)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if err := sleepWithContext(ctx, delay); err != nil {
+			return nil, err
+		}
 	}
+}
+
+// readResponse decodes the response body, returning an APIError if the response is not successful
+func (n *NClient) readResponse(req *http.Request, resp *http.Response) (map[string]interface{}, error) {
 	defer resp.Body.Close()
 
-	// Check if resp NoContent 
+	// Check if resp is not successful
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, newAPIError(req, resp)
+	}
+
+	// Check if resp NoContent
 	if resp.StatusCode == http.StatusNoContent {
 		return map[string]interface{}{}, nil
 	}
@@ -93,6 +255,231 @@ func (n *NClient) MakeRequestWithContext(ctx context.Context, method, endpoint,
 	return respBody, nil
 }
 
+// noRetryKey is the context key of requests that must not be retried
+type noRetryKey struct{}
+
+// WithoutRetry returns a context for requests that are never retried, whatever their method. The generated methods of operations
+// that create resources use it, as sending them again might create a duplicate resource.
+func WithoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+// shouldRetry returns true if a request can be sent again after the given attempt, a zero status code is a transport error
+func (n *NClient) shouldRetry(ctx context.Context, method string, attempt, statusCode int) bool {
+	if attempt >= n.options.MaxRetries {
+		return false
+	}
+
+	if noRetry, _ := ctx.Value(noRetryKey{}).(bool); noRetry {
+		return false
+	}
+
+	methodRetryable := false
+	for _, m := range n.options.RetryableMethods {
+		if strings.EqualFold(m, method) {
+			methodRetryable = true
+			break
+		}
+	}
+	if !methodRetryable {
+		return false
+	}
+
+	if statusCode == 0 {
+		return true
+	}
+
+	for _, code := range n.options.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay before the next attempt, honoring the Retry-After header of the response if any
+func (n *NClient) backoff(attempt int, resp *http.Response) time.Duration {
+	maxBackoff := n.options.MaxBackoff
+
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return time.Duration(math.Min(float64(retryAfter), float64(maxBackoff)))
+		}
+	}
+
+	// Exponential backoff with equal jitter, half of the delay is randomized
+	delay := math.Min(float64(n.options.MinBackoff)*math.Pow(2, float64(attempt)), float64(maxBackoff))
+	return time.Duration(delay/2 + rand.Float64()*delay/2)
+}
+
+// parseRetryAfter parses the Retry-After header, which is either a number of seconds or an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimiter is a token bucket, refilled with a token every 1/rate seconds up to the burst size
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+
+	if burst <= 0 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or the context is done
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+
+		now := time.Now()
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		l.last = now
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		if err := sleepWithContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// APIError is returned for responses with a non-2xx status code. It wraps ErrAPIRequest, so it can be checked with
+// errors.Is(err, ErrAPIRequest) or read with errors.As(err, &apiErr).
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+
+	// Code and Message are read from the NCP error fields of the response body, if any
+	Code    string
+	Message string
+	Details string
+
+	// Body is the raw response body
+	Body []byte
+
+	// Method and URL are the metadata of the failed request, Header is the header of the response
+	Method string
+	URL    string
+	Header http.Header
+}
+
+func (e *APIError) Error() string {
+	if e.Code != "" || e.Message != "" {
+		return fmt.Sprintf("%s: %s %s returned status %d - %s: %s", ErrAPIRequest, e.Method, e.URL, e.StatusCode, e.Code, e.Message)
+	}
+
+	return fmt.Sprintf("%s: %s %s returned status %d - %s", ErrAPIRequest, e.Method, e.URL, e.StatusCode, string(e.Body))
+}
+
+func (e *APIError) Unwrap() error {
+	return ErrAPIRequest
+}
+
+// IsNotFound returns true if the error is an APIError with the 404 status code, i.e. the resource is gone.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// newAPIError reads the response body of a failed request. NCP APIs return either
+// {"error": {"errorCode", "message", "details"}} or {"responseError": {"returnCode", "returnMessage"}}.
+func newAPIError(req *http.Request, resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		Header:     resp.Header,
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		apiErr.Message = fmt.Sprintf("error reading response body: %v", err)
+		return apiErr
+	}
+	apiErr.Body = body
+
+	var errBody struct {
+		Error *struct {
+			ErrorCode string `json:"errorCode"`
+			Message   string `json:"message"`
+			Details   string `json:"details"`
+		} `json:"error"`
+		ResponseError *struct {
+			ReturnCode    string `json:"returnCode"`
+			ReturnMessage string `json:"returnMessage"`
+		} `json:"responseError"`
+	}
+
+	if err := json.Unmarshal(body, &errBody); err != nil {
+		return apiErr
+	}
+
+	switch {
+	case errBody.Error != nil:
+		apiErr.Code = errBody.Error.ErrorCode
+		apiErr.Message = errBody.Error.Message
+		apiErr.Details = errBody.Error.Details
+	case errBody.ResponseError != nil:
+		apiErr.Code = errBody.ResponseError.ReturnCode
+		apiErr.Message = errBody.ResponseError.ReturnMessage
+	}
+
+	return apiErr
+}
+
 func (n *NClient) SetRequest(url *url.URL, queryParams map[string]string, reqBody, method string) (*http.Request, error) {
 	q := url.Query()
 	for key, value := range queryParams {
@@ -112,29 +499,136 @@ func (n *NClient) SetRequest(url *url.URL, queryParams map[string]string, reqBod
 }
 
 func (n *NClient) SetHeader(req *http.Request, url *url.URL, method string) {
+	setCommonHeaders(req)
 
+	// Make signature, the URL and method of the request are signed
+	_ = (&SignatureV2Authenticator{AccessKey: n.AccessKey, SecretKey: n.SecretKey}).Authenticate(req)
+}
+
+func setCommonHeaders(req *http.Request) {
+	headers := map[string]string{
+		"Content-Type":  "application/json",
+		"Cache-Control": "no-cache",
+		"Pragma":        "no-cache",
+	}
+
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+}
+
+// Authenticator authenticates a request before it is sent, i.e. by setting headers or query parameters. Requests are authenticated
+// again when retried, so implementations must overwrite the values they set.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// SignatureV2Authenticator signs requests with NCP API Gateway signature v2
+type SignatureV2Authenticator struct {
+	AccessKey string
+	SecretKey string
+}
+
+func (a *SignatureV2Authenticator) Authenticate(req *http.Request) error {
 	// Check if query string exists.
 	// If then, do not even add "?".
 	queryString := ""
-	if len(url.RawQuery) > 0 {
-		queryString = "?" + url.RawQuery
+	if len(req.URL.RawQuery) > 0 {
+		queryString = "?" + req.URL.RawQuery
 	}
 
 	timestamp := fmt.Sprintf("%d", time.Now().UnixMilli())
-	signature := makeSignature(method, url.Path+queryString, timestamp, n.AccessKey, n.SecretKey)
+	signature := makeSignature(req.Method, req.URL.Path+queryString, timestamp, a.AccessKey, a.SecretKey)
 
-	headers := map[string]string{
-		"Content-Type":             "application/json",
-		"x-ncp-apigw-timestamp":    timestamp,
-		"x-ncp-iam-access-key":     n.AccessKey,
-		"x-ncp-apigw-signature-v2": signature,
-		"Cache-Control":            "no-cache",
-		"Pragma":                   "no-cache",
+	req.Header.Set("x-ncp-apigw-timestamp", timestamp)
+	req.Header.Set("x-ncp-iam-access-key", a.AccessKey)
+	req.Header.Set("x-ncp-apigw-signature-v2", signature)
+
+	return nil
+}
+
+// BearerTokenAuthenticator sets the Authorization header with a bearer token
+type BearerTokenAuthenticator struct {
+	Token string
+}
+
+func (a *BearerTokenAuthenticator) Authenticate(req *http.Request) error {
+	if a.Token == "" {
+		return errors.New("bearer token is empty")
 	}
 
-	for key, value := range headers {
-		req.Header.Add(key, value)
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+
+	return nil
+}
+
+// APIKeyAuthenticator sets an API key, in a header or a query parameter named Name
+type APIKeyAuthenticator struct {
+	Name  string
+	Value string
+	// In is either "header" or "query"
+	In string
+}
+
+func (a *APIKeyAuthenticator) Authenticate(req *http.Request) error {
+	if a.Value == "" {
+		return fmt.Errorf("API key %s is empty", a.Name)
+	}
+
+	switch a.In {
+	case "header":
+		req.Header.Set(a.Name, a.Value)
+	case "query":
+		q := req.URL.Query()
+		q.Set(a.Name, a.Value)
+		req.URL.RawQuery = q.Encode()
+	default:
+		return fmt.Errorf("unsupported location of API key %s: %s", a.Name, a.In)
 	}
+
+	return nil
+}
+
+// authenticatorsFor returns the authenticators of the first security requirement that has an authenticator for every scheme
+func (n *NClient) authenticatorsFor(security [][]string) ([]Authenticator, error) {
+	if security == nil {
+		if n.options.Authenticator != nil {
+			return []Authenticator{n.options.Authenticator}, nil
+		}
+
+		return []Authenticator{&SignatureV2Authenticator{AccessKey: n.AccessKey, SecretKey: n.SecretKey}}, nil
+	}
+
+	// Operations declared with empty requirements, i.e. security: [], are not authenticated
+	if len(security) == 0 {
+		return nil, nil
+	}
+
+	// Clients that are not created with NewClient use the default authenticators
+	authenticators := n.authenticators
+	if authenticators == nil {
+		authenticators = defaultAuthenticators(n.AccessKey, n.SecretKey, n.options)
+	}
+
+	for _, requirement := range security {
+		var result []Authenticator
+		satisfied := true
+
+		for _, scheme := range requirement {
+			authenticator, ok := authenticators[scheme]
+			if !ok || authenticator == nil {
+				satisfied = false
+				break
+			}
+			result = append(result, authenticator)
+		}
+
+		if satisfied {
+			return result, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %v", ErrNoAuthenticator, security)
 }
 
 // For curl request
@@ -165,22 +659,38 @@ func diagOff[V, T interface{}](input func(ctx context.Context, elementType T, el
 	return v
 }
 
-// convertKeys recursively converts all keys in a map from camelCase to snake_case
-func convertKeys(input interface{}) interface{} {
+// convertKeys recursively converts all keys in a map from camelCase to snake_case, except for the keys of the maps at mapPaths.
+// The keys of a map (additionalProperties) are user data rather than property names, mapPaths are JSON pointers with the
+// property names of the response and "*" for any array index or map key.
+func convertKeys(input interface{}, mapPaths ...string) interface{} {
+	isMap := make(map[string]bool, len(mapPaths))
+	for _, mapPath := range mapPaths {
+		isMap[mapPath] = true
+	}
+
+	return convertKeysAt(input, "", isMap)
+}
+
+func convertKeysAt(input interface{}, path string, isMap map[string]bool) interface{} {
 	switch v := input.(type) {
 	case map[string]interface{}:
 		newMap := make(map[string]interface{})
 		for key, value := range v {
+			if isMap[path] {
+				newMap[key] = convertKeysAt(value, path+"/*", isMap)
+				continue
+			}
+
 			// Convert the key to snake_case
 			newKey := camelToSnake(key)
 			// Recursively convert nested values
-			newMap[newKey] = convertKeys(value)
+			newMap[newKey] = convertKeysAt(value, path+"/"+jsonPointerEscaper.Replace(key), isMap)
 		}
 		return newMap
 	case []interface{}:
 		newSlice := make([]interface{}, len(v))
 		for i, value := range v {
-			newSlice[i] = convertKeys(value)
+			newSlice[i] = convertKeysAt(value, path+"/*", isMap)
 		}
 		return newSlice
 	default:
@@ -188,6 +698,9 @@ func convertKeys(input interface{}) interface{} {
 	}
 }
 
+// jsonPointerEscaper escapes a key as a JSON pointer token
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
 func camelToSnake(s string) string {
 	var result strings.Builder
 	for i, r := range s {
@@ -199,6 +712,566 @@ func camelToSnake(s string) string {
 	return result.String()
 }
 
+// unwrapResponse descends into a response, following the reference tokens of a response path (JSON pointer).
+// Response keys are converted to snake_case, so each token is converted the same way before the lookup.
+func unwrapResponse(data map[string]interface{}, tokens ...string) (map[string]interface{}, error) {
+	var current interface{} = data
+
+	for _, token := range tokens {
+		switch v := current.(type) {
+		case map[string]interface{}:
+			value, ok := v[camelToSnake(token)]
+			if !ok {
+				return nil, fmt.Errorf("response path token %q not found in response", token)
+			}
+			current = value
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, fmt.Errorf("response path token %q is not a valid index in response", token)
+			}
+			current = v[index]
+		default:
+			return nil, fmt.Errorf("response path token %q cannot be resolved in response", token)
+		}
+	}
+
+	result, ok := current.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("response path does not resolve to an object in response")
+	}
+
+	return result, nil
+}
+
+// attrConverter converts a value of a decoded response to a Terraform value. Generated refresh functions build a converter for every
+// nested schema of a response out of the converters below, a nil value is converted to a null value of the schema's type.
+type attrConverter func(ctx context.Context, value interface{}) (attr.Value, error)
+
+func convertStringValue(_ context.Context, value interface{}) (attr.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case string:
+		return types.StringValue(v), nil
+	default:
+		// Values of a schema without a type, i.e. free-form values, are kept as JSON
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return types.StringValue(string(b)), nil
+	}
+}
+
+func convertBoolValue(_ context.Context, value interface{}) (attr.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return types.BoolNull(), nil
+	case bool:
+		return types.BoolValue(v), nil
+	default:
+		return nil, fmt.Errorf("unsupported type for bool: %T", value)
+	}
+}
+
+func convertInt32Value(_ context.Context, value interface{}) (attr.Value, error) {
+	if value == nil {
+		return types.Int32Null(), nil
+	}
+
+	f, err := toFloat64(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.Int32Value(int32(f)), nil
+}
+
+func convertInt64Value(_ context.Context, value interface{}) (attr.Value, error) {
+	if value == nil {
+		return types.Int64Null(), nil
+	}
+
+	f, err := toFloat64(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.Int64Value(int64(f)), nil
+}
+
+func convertFloat64Value(_ context.Context, value interface{}) (attr.Value, error) {
+	if value == nil {
+		return types.Float64Null(), nil
+	}
+
+	f, err := toFloat64(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.Float64Value(f), nil
+}
+
+// toFloat64 converts a decoded JSON number, which is a float64 unless the decoder is configured otherwise
+func toFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case json.Number:
+		return v.Float64()
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, fmt.Errorf("unsupported type for number: %T", value)
+	}
+}
+
+func convertListValue(ctx context.Context, value interface{}, elemType attr.Type, convertElem attrConverter) (attr.Value, error) {
+	if value == nil {
+		return types.ListNull(elemType), nil
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for list: %T", value)
+	}
+
+	elems := make([]attr.Value, 0, len(items))
+	for i, item := range items {
+		elem, err := convertElem(ctx, item)
+		if err != nil {
+			return nil, fmt.Errorf("error converting element %d: %w", i, err)
+		}
+		elems = append(elems, elem)
+	}
+
+	r, diags := types.ListValue(elemType, elems)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error from converting list: %v", diags)
+	}
+
+	return r, nil
+}
+
+func convertMapValue(ctx context.Context, value interface{}, elemType attr.Type, convertElem attrConverter) (attr.Value, error) {
+	if value == nil {
+		return types.MapNull(elemType), nil
+	}
+
+	entries, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for map: %T", value)
+	}
+
+	elems := make(map[string]attr.Value, len(entries))
+	for key, entry := range entries {
+		elem, err := convertElem(ctx, entry)
+		if err != nil {
+			return nil, fmt.Errorf("error converting key %s: %w", key, err)
+		}
+		elems[key] = elem
+	}
+
+	r, diags := types.MapValue(elemType, elems)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error from converting map: %v", diags)
+	}
+
+	return r, nil
+}
+
+func convertObjectValue(ctx context.Context, value interface{}, attrTypes map[string]attr.Type, converters map[string]attrConverter) (attr.Value, error) {
+	if value == nil {
+		return types.ObjectNull(attrTypes), nil
+	}
+
+	data, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for object: %T", value)
+	}
+
+	attrValues := make(map[string]attr.Value, len(attrTypes))
+	for field, convert := range converters {
+		fieldValue := data[field]
+
+		// In case of empty array in a nested object, logic assumes it null
+		if items, ok := fieldValue.([]interface{}); ok && len(items) == 0 {
+			fieldValue = nil
+		}
+
+		attrValue, err := convert(ctx, fieldValue)
+		if err != nil {
+			return nil, fmt.Errorf("error converting field %s: %w", field, err)
+		}
+		attrValues[field] = attrValue
+	}
+
+	r, diags := types.ObjectValue(attrTypes, attrValues)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error from converting object: %v", diags)
+	}
+
+	return r, nil
+}
+
+// Expanders build request values from Terraform values, the reverse of the converters above. Null and unknown values are expanded
+// to nil, so they are omitted from the request, except for nullable properties, see Nullable. Values are read through the valuable
+// interfaces, so custom types are supported.
+
+func isNullOrUnknown(v attr.Value) bool {
+	return v == nil || v.IsNull() || v.IsUnknown()
+}
+
+func expandString(ctx context.Context, v attr.Value) (*string, error) {
+	if isNullOrUnknown(v) {
+		return nil, nil
+	}
+
+	s, ok := v.(basetypes.StringValuable)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for string: %T", v)
+	}
+
+	sv, diags := s.ToStringValue(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error from expanding string: %v", diags)
+	}
+
+	return sv.ValueStringPointer(), nil
+}
+
+func expandBool(ctx context.Context, v attr.Value) (*bool, error) {
+	if isNullOrUnknown(v) {
+		return nil, nil
+	}
+
+	b, ok := v.(basetypes.BoolValuable)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for bool: %T", v)
+	}
+
+	bv, diags := b.ToBoolValue(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error from expanding bool: %v", diags)
+	}
+
+	return bv.ValueBoolPointer(), nil
+}
+
+func expandInt32(ctx context.Context, v attr.Value) (*int32, error) {
+	if i, ok := v.(basetypes.Int32Valuable); ok && !isNullOrUnknown(v) {
+		iv, diags := i.ToInt32Value(ctx)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error from expanding int32: %v", diags)
+		}
+
+		return iv.ValueInt32Pointer(), nil
+	}
+
+	f, err := expandFloat64(ctx, v)
+	if err != nil || f == nil {
+		return nil, err
+	}
+
+	r := int32(*f)
+	return &r, nil
+}
+
+func expandInt64(ctx context.Context, v attr.Value) (*int64, error) {
+	if i, ok := v.(basetypes.Int64Valuable); ok && !isNullOrUnknown(v) {
+		iv, diags := i.ToInt64Value(ctx)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error from expanding int64: %v", diags)
+		}
+
+		return iv.ValueInt64Pointer(), nil
+	}
+
+	f, err := expandFloat64(ctx, v)
+	if err != nil || f == nil {
+		return nil, err
+	}
+
+	r := int64(*f)
+	return &r, nil
+}
+
+// expandFloat64 expands any numeric value, as integer properties may be mapped to number attributes and the other way around
+func expandFloat64(ctx context.Context, v attr.Value) (*float64, error) {
+	if isNullOrUnknown(v) {
+		return nil, nil
+	}
+
+	var r float64
+	var diags diag.Diagnostics
+
+	switch n := v.(type) {
+	case basetypes.Float64Valuable:
+		var fv basetypes.Float64Value
+		fv, diags = n.ToFloat64Value(ctx)
+		r = fv.ValueFloat64()
+	case basetypes.Int64Valuable:
+		var iv basetypes.Int64Value
+		iv, diags = n.ToInt64Value(ctx)
+		r = float64(iv.ValueInt64())
+	case basetypes.Int32Valuable:
+		var iv basetypes.Int32Value
+		iv, diags = n.ToInt32Value(ctx)
+		r = float64(iv.ValueInt32())
+	case basetypes.NumberValuable:
+		var nv basetypes.NumberValue
+		nv, diags = n.ToNumberValue(ctx)
+		if !diags.HasError() {
+			r, _ = nv.ValueBigFloat().Float64()
+		}
+	default:
+		return nil, fmt.Errorf("unsupported type for number: %T", v)
+	}
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("error from expanding number: %v", diags)
+	}
+
+	return &r, nil
+}
+
+func expandList[T any](ctx context.Context, v attr.Value, expandElem func(context.Context, attr.Value) (T, error)) ([]T, error) {
+	if isNullOrUnknown(v) {
+		return nil, nil
+	}
+
+	var elems []attr.Value
+	var diags diag.Diagnostics
+
+	switch l := v.(type) {
+	case basetypes.ListValuable:
+		var lv basetypes.ListValue
+		lv, diags = l.ToListValue(ctx)
+		elems = lv.Elements()
+	case basetypes.SetValuable:
+		var sv basetypes.SetValue
+		sv, diags = l.ToSetValue(ctx)
+		elems = sv.Elements()
+	default:
+		return nil, fmt.Errorf("unsupported type for list: %T", v)
+	}
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("error from expanding list: %v", diags)
+	}
+
+	r := make([]T, 0, len(elems))
+	for i, elem := range elems {
+		e, err := expandElem(ctx, elem)
+		if err != nil {
+			return nil, fmt.Errorf("error expanding element %d: %w", i, err)
+		}
+		r = append(r, e)
+	}
+
+	return r, nil
+}
+
+func expandMap[T any](ctx context.Context, v attr.Value, expandElem func(context.Context, attr.Value) (T, error)) (map[string]T, error) {
+	if isNullOrUnknown(v) {
+		return nil, nil
+	}
+
+	m, ok := v.(basetypes.MapValuable)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for map: %T", v)
+	}
+
+	mv, diags := m.ToMapValue(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error from expanding map: %v", diags)
+	}
+
+	r := make(map[string]T, len(mv.Elements()))
+	for key, elem := range mv.Elements() {
+		e, err := expandElem(ctx, elem)
+		if err != nil {
+			return nil, fmt.Errorf("error expanding key %s: %w", key, err)
+		}
+		r[key] = e
+	}
+
+	return r, nil
+}
+
+// expandObjectAttributes returns the attributes of an object value, used by the expanders of request structs
+func expandObjectAttributes(ctx context.Context, v attr.Value) (map[string]attr.Value, error) {
+	if isNullOrUnknown(v) {
+		return nil, nil
+	}
+
+	o, ok := v.(basetypes.ObjectValuable)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for object: %T", v)
+	}
+
+	ov, diags := o.ToObjectValue(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error from expanding object: %v", diags)
+	}
+
+	return ov.Attributes(), nil
+}
+
+// expandAny expands a value of a schema without a type, i.e. free-form values, to its JSON value
+func expandAny(ctx context.Context, v attr.Value) (interface{}, error) {
+	if isNullOrUnknown(v) {
+		return nil, nil
+	}
+
+	switch v.(type) {
+	case basetypes.StringValuable:
+		return expandString(ctx, v)
+	case basetypes.BoolValuable:
+		return expandBool(ctx, v)
+	case basetypes.Int64Valuable:
+		return expandInt64(ctx, v)
+	case basetypes.Int32Valuable:
+		return expandInt32(ctx, v)
+	case basetypes.Float64Valuable, basetypes.NumberValuable:
+		return expandFloat64(ctx, v)
+	case basetypes.ListValuable, basetypes.SetValuable:
+		return expandList(ctx, v, expandAny)
+	case basetypes.MapValuable, basetypes.ObjectValuable:
+		return expandAnyMap(ctx, v)
+	default:
+		return nil, fmt.Errorf("unsupported type: %T", v)
+	}
+}
+
+// Nullable is the request field of a nullable property. A nil *Nullable is omitted from the request, like the pointer fields of
+// other properties, and a Nullable that isn't Valid is sent as an explicit JSON null, i.e. to clear the property on update.
+type Nullable[T any] struct {
+	Value T
+	Valid bool
+}
+
+// NewNullable returns a field that is sent with the given value
+func NewNullable[T any](value T) *Nullable[T] {
+	return &Nullable[T]{Value: value, Valid: true}
+}
+
+// Null returns a field that is sent as an explicit JSON null
+func Null[T any]() *Nullable[T] {
+	return &Nullable[T]{}
+}
+
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.Value)
+}
+
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = Nullable[T]{}
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &n.Value); err != nil {
+		return err
+	}
+	n.Valid = true
+
+	return nil
+}
+
+// expandNullable expands a value of a nullable property. Unlike the other expanders, a null value is expanded to an explicit null,
+// only unknown values are omitted from the request.
+func expandNullable[T any](ctx context.Context, v attr.Value, expandValue func(context.Context, attr.Value) (T, error)) (*Nullable[T], error) {
+	if v == nil || v.IsUnknown() {
+		return nil, nil
+	}
+
+	if v.IsNull() {
+		return Null[T](), nil
+	}
+
+	value, err := expandValue(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewNullable(value), nil
+}
+
+// expandAnyMap expands a map or object value of a free-form object schema
+func expandAnyMap(ctx context.Context, v attr.Value) (map[string]interface{}, error) {
+	if isNullOrUnknown(v) {
+		return nil, nil
+	}
+
+	if _, ok := v.(basetypes.MapValuable); ok {
+		return expandMap(ctx, v, expandAny)
+	}
+
+	attributes, err := expandObjectAttributes(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+
+	r := make(map[string]interface{}, len(attributes))
+	for key, attribute := range attributes {
+		e, err := expandAny(ctx, attribute)
+		if err != nil {
+			return nil, fmt.Errorf("error expanding attribute %s: %w", key, err)
+		}
+		r[key] = e
+	}
+
+	return r, nil
+}
+
+// queryValue formats a request field as a query parameter value. Pointers are dereferenced, lists are joined with commas and
+// objects are written as JSON. A nil value is formatted as an empty string.
+func queryValue(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return ""
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return ""
+	case reflect.String:
+		return rv.String()
+	case reflect.Slice, reflect.Array:
+		values := make([]string, rv.Len())
+		for i := range values {
+			values[i] = queryValue(rv.Index(i).Interface())
+		}
+		return strings.Join(values, ",")
+	case reflect.Map, reflect.Struct:
+		b, err := json.Marshal(rv.Interface())
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	default:
+		return fmt.Sprint(rv.Interface())
+	}
+}
+
 func ClearDoubleQuote(s string) string {
 	return strings.Replace(strings.Replace(strings.Replace(s, "\\", "", -1), "\"", "", -1), `"`, "", -1)
 }
@@ -5,23 +5,30 @@
  * Refresh Template
  * Required data are as follows
  *
+ *		PackageName            string
  *		MethodName             string
  *		RequestQueryParameters string
  *		RequestBodyParameters  string
+ *		Expanders              string
  *		FunctionName           string
  *		Query                  string
  *		Body                   string
  *		Path                   string
  *		Method                 string
+ *		Security               string
+ *		MapPaths               string
+ *		NoRetry                bool
+ *		HasBody                bool
  * ================================================================================= */
 
-package ncloudsdk
+package {{.PackageName}}
 
 import (
 	"context"
+{{- if .HasBody }}
 	"encoding/json"
+{{- end }}
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -31,6 +38,8 @@ import (
 
 {{.RequestBodyParameters}}
 
+{{.Expanders}}
+
 {{.FunctionName}}
 	query := map[string]string{}
 
@@ -39,8 +48,13 @@ import (
     {{.Body}}
 
 	url := n.BaseURL {{.Path}}
+{{- if .NoRetry }}
+
+	// The operation creates a resource, so it's never retried
+	ctx = WithoutRetry(ctx)
+{{- end }}
 
-	response, err := n.MakeRequestWithContext(ctx, "{{.Method}}", url, body, query)
+	response, err := n.MakeRequestWithSecurity(ctx, {{.Security}}, "{{.Method}}", url, body, query)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +62,7 @@ import (
 		return nil, fmt.Errorf("output is nil")
 	}
 
-	snake_case_response := convertKeys(response).(map[string]interface{})
+	snake_case_response := convertKeys(response{{ if .MapPaths }}, {{.MapPaths}}{{ end }}).(map[string]interface{})
 
 	return snake_case_response, nil
 }
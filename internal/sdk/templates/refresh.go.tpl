@@ -8,8 +8,10 @@
  *		Model             string
  *		MethodName        string
  *		RefreshLogic      string
- *		PossibleTypes     string
- *		ConditionalObjectFieldsWithNull string
+ *		AttrTypes         string
+ *		AttrConverters    string
+ *		Converters        string
+ *		ResponsePath      string
  * ================================================================================= */
 
 type {{.MethodName}}Response struct {
@@ -18,72 +20,30 @@ type {{.MethodName}}Response struct {
 
 func ConvertToFrameworkTypes_{{.MethodName}}(ctx context.Context, data map[string]interface{}) (*{{.MethodName}}Response, error) {
 	var dto {{.MethodName}}Response
-
+{{ if .ResponsePath }}
+	data, err := unwrapResponse(data, {{.ResponsePath}})
+	if err != nil {
+		return nil, err
+	}
+{{ end }}
     {{.RefreshLogic}}
 
 	return &dto, nil
 }
 
 func convertToObject_{{.MethodName}}(ctx context.Context, data map[string]interface{}) (types.Object, error) {
-	attrTypes := make(map[string]attr.Type)
-	attrValues := make(map[string]attr.Value)
-
-    possibleTypes := map[string]attr.Type{
-        {{.PossibleTypes}}
-	}
-
-	for field, fieldType := range possibleTypes {
-		attrTypes[field] = fieldType
-
-		if value, exists := data[field]; exists {
-
-			{{.ConvertValueWithNullInEmptyArrCase}}
-
-			attrValue, err := convertValueToAttr_{{.MethodName}}(value)
-			if err != nil {
-				return types.Object{}, fmt.Errorf("error converting field %s: %v", field, err)
-			}
-			attrValues[field] = attrValue
-		} else {
-            {{.ConditionalObjectFieldsWithNull}}
-
-			switch fieldType {
-			case types.StringType:
-				attrValues[field] = types.StringNull()
-			case types.Int64Type:
-				attrValues[field] = types.Int64Null()
-			case types.BoolType:
-				attrValues[field] = types.BoolNull()
-			}
-		}
-	}
-
-	r, diag := types.ObjectValue(attrTypes, attrValues)
-	if diag.HasError() {
-		return types.Object{}, fmt.Errorf("error from converting object: %v", diag)
+	r, err := convertObjectValue(ctx, data, map[string]attr.Type{
+		{{.AttrTypes}}
+	}, map[string]attrConverter{
+		{{.AttrConverters}}
+	})
+	if err != nil {
+		return types.Object{}, fmt.Errorf("error from converting object: %w", err)
 	}
 
-	// OK
-	return r, nil
+	return r.(types.Object), nil
 }
 
-func convertValueToAttr_{{.MethodName}}(value interface{}) (attr.Value, error) {
-     switch v := value.(type) {
-     case string:
-         return types.StringValue(v), nil
-     case int32:
-         return types.Int32Value(v), nil
-     case int64:
-         return types.Int64Value(v), nil
-     case float64:
-         return types.Float64Value(v), nil
-     case bool:
-         return types.BoolValue(v), nil
-     case nil:
-         return types.StringNull(), nil
-     default:
-         return nil, fmt.Errorf("unsupported type: %T", value)
-     }
- }
+{{.Converters}}
 
 {{ end }}
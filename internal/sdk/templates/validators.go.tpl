@@ -0,0 +1,177 @@
+{{ define "Validators" }}
+/* =================================================================================
+ * NCLOUD SDK LAYER FOR TERRAFORM CODEGEN - DO NOT EDIT
+ * =================================================================================
+ * Validators Template
+ * Required data are as follows
+ *
+ *		PackageName            string
+ * ================================================================================= */
+
+package {{.PackageName}}
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Validators of the OpenAPI keywords that terraform-plugin-framework-validators has no equivalent for, which are referenced by the
+// provider code spec, i.e. multipleOf and the bounds of number attributes.
+
+var (
+	_ validator.Int32   = MultipleOfValidator{}
+	_ validator.Int64   = MultipleOfValidator{}
+	_ validator.Float64 = MultipleOfValidator{}
+	_ validator.Number  = MultipleOfValidator{}
+	_ validator.Number  = NumberBoundValidator{}
+)
+
+// MultipleOfValidator validates that a numeric value is a multiple of a value, i.e. the `multipleOf` keyword
+type MultipleOfValidator struct {
+	value float64
+}
+
+// MultipleOf returns a validator which ensures that any configured int32, int64, float64 or number value is a multiple of the given value
+func MultipleOf(value float64) MultipleOfValidator {
+	return MultipleOfValidator{value: value}
+}
+
+func (v MultipleOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a multiple of %v", v.value)
+}
+
+func (v MultipleOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v MultipleOfValidator) ValidateInt32(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt32()
+	if !v.isMultipleOfInt(int64(value)) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value))
+	}
+}
+
+func (v MultipleOfValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+	if !v.isMultipleOfInt(value) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value))
+	}
+}
+
+func (v MultipleOfValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+	if !v.isMultipleOf(value) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("Attribute %s %s, got: %v", req.Path, v.Description(ctx), value))
+	}
+}
+
+func (v MultipleOfValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value, _ := req.ConfigValue.ValueBigFloat().Float64()
+	if !v.isMultipleOf(value) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueBigFloat().Text('g', -1)))
+	}
+}
+
+// isMultipleOfInt is exact for integer multiples, which are the common case of integer attributes
+func (v MultipleOfValidator) isMultipleOfInt(value int64) bool {
+	if v.value == math.Trunc(v.value) && math.Abs(v.value) >= 1 && math.Abs(v.value) < math.MaxInt64 {
+		return value%int64(v.value) == 0
+	}
+
+	return v.isMultipleOf(float64(value))
+}
+
+// isMultipleOf allows for the rounding errors of floating point division, i.e. 0.3 is a multiple of 0.1
+func (v MultipleOfValidator) isMultipleOf(value float64) bool {
+	if v.value == 0 {
+		return true
+	}
+
+	quotient := value / v.value
+
+	return math.Abs(quotient-math.Round(quotient)) <= 1e-9*math.Max(1, math.Abs(quotient))
+}
+
+// NumberBoundValidator validates that a number value is within a lower or upper bound, i.e. the `minimum`, `maximum`,
+// `exclusiveMinimum` and `exclusiveMaximum` keywords
+type NumberBoundValidator struct {
+	bound     float64
+	upper     bool
+	exclusive bool
+}
+
+// NumberAtLeast returns a validator which ensures that any configured number value is greater than or equal to the given minimum
+func NumberAtLeast(minimum float64) NumberBoundValidator {
+	return NumberBoundValidator{bound: minimum}
+}
+
+// NumberGreaterThan returns a validator which ensures that any configured number value is greater than the given minimum
+func NumberGreaterThan(minimum float64) NumberBoundValidator {
+	return NumberBoundValidator{bound: minimum, exclusive: true}
+}
+
+// NumberAtMost returns a validator which ensures that any configured number value is less than or equal to the given maximum
+func NumberAtMost(maximum float64) NumberBoundValidator {
+	return NumberBoundValidator{bound: maximum, upper: true}
+}
+
+// NumberLessThan returns a validator which ensures that any configured number value is less than the given maximum
+func NumberLessThan(maximum float64) NumberBoundValidator {
+	return NumberBoundValidator{bound: maximum, upper: true, exclusive: true}
+}
+
+func (v NumberBoundValidator) Description(_ context.Context) string {
+	switch {
+	case v.upper && v.exclusive:
+		return fmt.Sprintf("value must be less than %v", v.bound)
+	case v.upper:
+		return fmt.Sprintf("value must be at most %v", v.bound)
+	case v.exclusive:
+		return fmt.Sprintf("value must be greater than %v", v.bound)
+	default:
+		return fmt.Sprintf("value must be at least %v", v.bound)
+	}
+}
+
+func (v NumberBoundValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v NumberBoundValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	// Compare the value to the bound, so the sign is positive if the value is above the bound
+	comparison := value.Cmp(big.NewFloat(v.bound))
+	if v.upper {
+		comparison = -comparison
+	}
+
+	if comparison < 0 || (v.exclusive && comparison == 0) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value.Text('g', -1)))
+	}
+}
+{{ end }}
@@ -0,0 +1,294 @@
+// Tests of the generated client, which are run against the SDK generated from testdata/golden by TestGenerate_Compile.
+
+package ncloudsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	n := NewClient("", "", "", ClientOptions{
+		MinBackoff: 10 * time.Millisecond,
+		MaxBackoff: time.Second,
+	})
+
+	testCases := map[string]struct {
+		attempt    int
+		retryAfter string
+		min        time.Duration
+		max        time.Duration
+	}{
+		"first attempt": {
+			attempt: 0,
+			min:     5 * time.Millisecond,
+			max:     10 * time.Millisecond,
+		},
+		"exponential": {
+			attempt: 3,
+			min:     40 * time.Millisecond,
+			max:     80 * time.Millisecond,
+		},
+		"capped at max backoff": {
+			attempt: 20,
+			min:     500 * time.Millisecond,
+			max:     time.Second,
+		},
+		"retry-after seconds": {
+			attempt:    0,
+			retryAfter: "1",
+			min:        time.Second,
+			max:        time.Second,
+		},
+		"retry-after capped at max backoff": {
+			attempt:    0,
+			retryAfter: "120",
+			min:        time.Second,
+			max:        time.Second,
+		},
+		"retry-after date in the past": {
+			attempt:    0,
+			retryAfter: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat),
+			min:        0,
+			max:        0,
+		},
+		"retry-after date capped at max backoff": {
+			attempt:    0,
+			retryAfter: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat),
+			min:        time.Second,
+			max:        time.Second,
+		},
+		"invalid retry-after": {
+			attempt:    0,
+			retryAfter: "soon",
+			min:        5 * time.Millisecond,
+			max:        10 * time.Millisecond,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &http.Response{Header: http.Header{}}
+			if testCase.retryAfter != "" {
+				resp.Header.Set("Retry-After", testCase.retryAfter)
+			}
+
+			for i := 0; i < 100; i++ {
+				got := n.backoff(testCase.attempt, resp)
+				if got < testCase.min || got > testCase.max {
+					t.Fatalf("expected backoff between %s and %s, got %s", testCase.min, testCase.max, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	if newRateLimiter(0, 5) != nil {
+		t.Errorf("expected no rate limiter without a rate")
+	}
+
+	l := newRateLimiter(20, 2)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// The burst of 2 is available immediately, the third token is refilled after 1/20 seconds
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("expected the third request to wait for a token, waited %s", elapsed)
+	}
+}
+
+func TestRateLimiter_ContextDone(t *testing.T) {
+	t.Parallel()
+
+	l := newRateLimiter(0.001, 1)
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestMakeRequestWithSecurity_Retry(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		method        string
+		noRetry       bool
+		expectedCalls int
+	}{
+		"idempotent method": {
+			method:        http.MethodGet,
+			expectedCalls: 3,
+		},
+		"non-idempotent method": {
+			method:        http.MethodPost,
+			expectedCalls: 1,
+		},
+		"create operation": {
+			method:        http.MethodGet,
+			noRetry:       true,
+			expectedCalls: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls < 3 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+
+				_, _ = w.Write([]byte(`{"id": "1"}`))
+			}))
+			defer server.Close()
+
+			n := NewClient(server.URL, "access", "secret", ClientOptions{
+				MinBackoff: time.Millisecond,
+				MaxBackoff: 2 * time.Millisecond,
+			})
+
+			ctx := context.Background()
+			if testCase.noRetry {
+				ctx = WithoutRetry(ctx)
+			}
+
+			_, err := n.MakeRequestWithSecurity(ctx, nil, testCase.method, server.URL+"/things", "", nil)
+
+			if calls != testCase.expectedCalls {
+				t.Errorf("expected %d calls, got %d", testCase.expectedCalls, calls)
+			}
+
+			if testCase.expectedCalls < 3 {
+				var apiErr *APIError
+				if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+					t.Errorf("expected an APIError with status 503, got %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestNullable_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	name := "server"
+
+	type request struct {
+		Name        *Nullable[*string] `json:"name,omitempty"`
+		Description *Nullable[*string] `json:"description,omitempty"`
+		Tags        *Nullable[*string] `json:"tags,omitempty"`
+	}
+
+	got, err := json.Marshal(request{
+		Name:        NewNullable(&name),
+		Description: Null[*string](),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := `{"name":"server","description":null}`; string(got) != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+
+	var decoded request
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if decoded.Name == nil || !decoded.Name.Valid || *decoded.Name.Value != name {
+		t.Errorf("expected name to be decoded, got %+v", decoded.Name)
+	}
+}
+
+func TestExpandNullable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected string
+	}{
+		"value": {
+			value:    types.StringValue("server"),
+			expected: `"server"`,
+		},
+		"null": {
+			value:    types.StringNull(),
+			expected: `null`,
+		},
+		"unknown": {
+			value:    types.StringUnknown(),
+			expected: ``,
+		},
+		"missing": {
+			value:    nil,
+			expected: ``,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandNullable(context.Background(), testCase.value, expandString)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got == nil {
+				if testCase.expected != "" {
+					t.Errorf("expected %s, got an omitted field", testCase.expected)
+				}
+				return
+			}
+
+			b, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(b) != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, b)
+			}
+		})
+	}
+}
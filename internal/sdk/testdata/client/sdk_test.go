@@ -0,0 +1,429 @@
+// Tests of the generated operations, request types and converters of testdata/golden, which are run against the generated SDK by
+// TestGenerate_Compile.
+
+package ncloudsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	tagType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"key":   types.StringType,
+		"value": types.StringType,
+	}}
+
+	contactType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"email":  types.StringType,
+		"phones": types.ListType{ElemType: types.StringType},
+	}}
+
+	ownerType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":    types.StringType,
+		"contact": contactType,
+	}}
+)
+
+func TestConvertToFrameworkTypes_GETGetThingDetail(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		response      string
+		expected      *GETGetThingDetailResponse
+		expectedError bool
+	}{
+		"all types": {
+			response: `{
+				"requestId": "1",
+				"thingList": [{
+					"thingNo": "42",
+					"thingName": "thing",
+					"size": 5,
+					"ratio": 0.5,
+					"enabled": true,
+					"tagList": [{"key": "env", "value": "dev"}],
+					"limits": {"cpu": 2},
+					"grid": [[1, 2], [3]],
+					"owner": {"name": "owner", "contact": {"email": "owner@example.com", "phones": ["010"]}}
+				}]
+			}`,
+			expected: &GETGetThingDetailResponse{
+				Thingno:   types.StringValue("42"),
+				Thingname: types.StringValue("thing"),
+				Size:      types.Int32Value(5),
+				Ratio:     types.Float64Value(0.5),
+				Enabled:   types.BoolValue(true),
+				TagList: types.ListValueMust(tagType, []attr.Value{
+					types.ObjectValueMust(tagType.AttrTypes, map[string]attr.Value{
+						"key":   types.StringValue("env"),
+						"value": types.StringValue("dev"),
+					}),
+				}),
+				Limits: types.MapValueMust(types.Int64Type, map[string]attr.Value{
+					"cpu": types.Int64Value(2),
+				}),
+				Grid: types.ListValueMust(types.ListType{ElemType: types.Int64Type}, []attr.Value{
+					types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(2)}),
+					types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(3)}),
+				}),
+				Owner: types.ObjectValueMust(ownerType.AttrTypes, map[string]attr.Value{
+					"name": types.StringValue("owner"),
+					"contact": types.ObjectValueMust(contactType.AttrTypes, map[string]attr.Value{
+						"email":  types.StringValue("owner@example.com"),
+						"phones": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("010")}),
+					}),
+				}),
+			},
+		},
+		"missing fields": {
+			response: `{"thingList": [{"thingNo": "42"}]}`,
+			expected: &GETGetThingDetailResponse{
+				Thingno:   types.StringValue("42"),
+				Thingname: types.StringNull(),
+				Size:      types.Int32Null(),
+				Ratio:     types.Float64Null(),
+				Enabled:   types.BoolNull(),
+				TagList:   types.ListNull(tagType),
+				Limits:    types.MapNull(types.Int64Type),
+				Grid:      types.ListNull(types.ListType{ElemType: types.Int64Type}),
+				Owner:     types.ObjectNull(ownerType.AttrTypes),
+			},
+		},
+		"missing response path": {
+			response:      `{"thingList": []}`,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var response map[string]interface{}
+			if err := json.Unmarshal([]byte(testCase.response), &response); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// Operations return the response with snake_case keys
+			data := convertKeys(response).(map[string]interface{})
+
+			got, err := ConvertToFrameworkTypes_GETGetThingDetail(context.Background(), data)
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			fields := map[string][2]attr.Value{
+				"thing_no":   {got.Thingno, testCase.expected.Thingno},
+				"thing_name": {got.Thingname, testCase.expected.Thingname},
+				"size":       {got.Size, testCase.expected.Size},
+				"ratio":      {got.Ratio, testCase.expected.Ratio},
+				"enabled":    {got.Enabled, testCase.expected.Enabled},
+				"tag_list":   {got.TagList, testCase.expected.TagList},
+				"limits":     {got.Limits, testCase.expected.Limits},
+				"grid":       {got.Grid, testCase.expected.Grid},
+				"owner":      {got.Owner, testCase.expected.Owner},
+			}
+
+			for field, values := range fields {
+				if !values[0].Equal(values[1]) {
+					t.Errorf("expected %s to be %s, got %s", field, values[1], values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestExpand_PUTThingsThingNoRequestBody(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"thing_name":  types.StringType,
+		"description": types.StringType,
+		"tag_list":    types.ListType{ElemType: tagType},
+		"owner":       ownerType,
+		"limits":      types.MapType{ElemType: types.Int32Type},
+	}
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected string
+	}{
+		"all types": {
+			value: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"thing_name":  types.StringValue(`say "hi"`),
+				"description": types.StringValue("thing"),
+				"tag_list": types.ListValueMust(tagType, []attr.Value{
+					types.ObjectValueMust(tagType.AttrTypes, map[string]attr.Value{
+						"key":   types.StringValue("env"),
+						"value": types.StringValue("dev"),
+					}),
+				}),
+				"owner": types.ObjectValueMust(ownerType.AttrTypes, map[string]attr.Value{
+					"name": types.StringValue("owner"),
+					"contact": types.ObjectValueMust(contactType.AttrTypes, map[string]attr.Value{
+						"email":  types.StringNull(),
+						"phones": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("010")}),
+					}),
+				}),
+				"limits": types.MapValueMust(types.Int32Type, map[string]attr.Value{
+					"cpu": types.Int32Value(2),
+				}),
+			}),
+			expected: `{"limits":{"cpu":2},"owner":{"name":"owner","contact":{"phones":["010"]}},"tagList":[{"key":"env","value":"dev"}],"description":"thing","thingName":"say \"hi\""}`,
+		},
+		"null and unknown": {
+			value: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"thing_name":  types.StringUnknown(),
+				"description": types.StringNull(),
+				"tag_list":    types.ListNull(tagType),
+				"owner":       types.ObjectNull(ownerType.AttrTypes),
+				"limits":      types.MapUnknown(types.Int32Type),
+			}),
+			expected: `{"description":null}`,
+		},
+		"null object": {
+			value:    types.ObjectNull(attrTypes),
+			expected: `null`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Expand_PUTThingsThingNoRequestBody(context.Background(), testCase.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			b, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(b) != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, b)
+			}
+		})
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		statusCode       int
+		body             string
+		expectedCode     string
+		expectedMessage  string
+		expectedDetails  string
+		expectedNotFound bool
+	}{
+		"error": {
+			statusCode:      http.StatusBadRequest,
+			body:            `{"error": {"errorCode": "100", "message": "Invalid parameter", "details": "thingName is required"}}`,
+			expectedCode:    "100",
+			expectedMessage: "Invalid parameter",
+			expectedDetails: "thingName is required",
+		},
+		"response error": {
+			statusCode:       http.StatusNotFound,
+			body:             `{"responseError": {"returnCode": "1300", "returnMessage": "Thing not found"}}`,
+			expectedCode:     "1300",
+			expectedMessage:  "Thing not found",
+			expectedNotFound: true,
+		},
+		"not json": {
+			statusCode: http.StatusBadGateway,
+			body:       `Bad Gateway`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "https://example.com/getThingDetail", nil)
+			resp := &http.Response{
+				StatusCode: testCase.statusCode,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(testCase.body)),
+			}
+
+			var err error = newAPIError(req, resp)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || !errors.Is(err, ErrAPIRequest) {
+				t.Fatalf("expected an APIError wrapping ErrAPIRequest, got %v", err)
+			}
+
+			if apiErr.StatusCode != testCase.statusCode {
+				t.Errorf("expected status code %d, got %d", testCase.statusCode, apiErr.StatusCode)
+			}
+
+			if apiErr.Code != testCase.expectedCode || apiErr.Message != testCase.expectedMessage || apiErr.Details != testCase.expectedDetails {
+				t.Errorf("expected %q, %q and %q, got %q, %q and %q", testCase.expectedCode, testCase.expectedMessage, testCase.expectedDetails, apiErr.Code, apiErr.Message, apiErr.Details)
+			}
+
+			if string(apiErr.Body) != testCase.body {
+				t.Errorf("expected body %s, got %s", testCase.body, apiErr.Body)
+			}
+
+			if IsNotFound(err) != testCase.expectedNotFound {
+				t.Errorf("expected IsNotFound to be %t", testCase.expectedNotFound)
+			}
+		})
+	}
+}
+
+func TestPUTThingsThingNo(t *testing.T) {
+	t.Parallel()
+
+	var method, path, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		method, path, body = r.Method, r.URL.Path, string(b)
+
+		_, _ = w.Write([]byte(`{"requestId": "1", "thingList": [{"thingNo": "42"}]}`))
+	}))
+	defer server.Close()
+
+	n := NewClient(server.URL, "access", "secret")
+
+	thingNo := int64(42)
+	thingName := `say "hi"`
+
+	got, err := n.PUTThingsThingNo(context.Background(), &PUTThingsThingNoRequestQuery{ThingNo: &thingNo}, &PUTThingsThingNoRequestBody{
+		ThingName:   &thingName,
+		Description: Null[*string](),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if method != http.MethodPut || path != "/things/42" {
+		t.Errorf("expected PUT /things/42, got %s %s", method, path)
+	}
+
+	// The marshalled body is sent unchanged, including escaped quotes and explicit nulls
+	if expected := `{"description":null,"thingName":"say \"hi\""}`; body != expected {
+		t.Errorf("expected body %s, got %s", expected, body)
+	}
+
+	if got["request_id"] != "1" {
+		t.Errorf("expected the response with snake_case keys, got %v", got)
+	}
+}
+
+func TestGETGetThingDetail(t *testing.T) {
+	t.Parallel()
+
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"responseError": {"returnCode": "1300", "returnMessage": "Thing not found"}}`))
+	}))
+	defer server.Close()
+
+	n := NewClient(server.URL, "access", "secret")
+
+	thingNo := "42"
+	pageSize := int32(10)
+
+	_, err := n.GETGetThingDetail(context.Background(), &GETGetThingDetailRequestQuery{ThingNo: &thingNo, PageSize: &pageSize})
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	if query["thingNo"][0] != "42" || query["pageSize"][0] != "10" {
+		t.Errorf("expected the query parameters thingNo=42 and pageSize=10, got %v", query)
+	}
+}
+
+func TestGETGetThingDetail_MapKeys(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"requestId": "1", "thingList": [{"thingNo": "42", "limits": {"maxCpu": 2, "memorySize": 4}}]}`))
+	}))
+	defer server.Close()
+
+	n := NewClient(server.URL, "access", "secret")
+
+	thingNo := "42"
+
+	response, err := n.GETGetThingDetail(context.Background(), &GETGetThingDetailRequestQuery{ThingNo: &thingNo})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := ConvertToFrameworkTypes_GETGetThingDetail(context.Background(), response)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Map keys are user data, so they keep their case while the property names are converted to snake_case
+	expected := types.MapValueMust(types.Int64Type, map[string]attr.Value{
+		"maxCpu":     types.Int64Value(2),
+		"memorySize": types.Int64Value(4),
+	})
+	if !got.Limits.Equal(expected) {
+		t.Errorf("expected limits to be %s, got %s", expected, got.Limits)
+	}
+
+	if !got.Thingno.Equal(types.StringValue("42")) {
+		t.Errorf("expected thing_no to be 42, got %s", got.Thingno)
+	}
+}
+
+func TestGETCreateThing_NoRetry(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	n := NewClient(server.URL, "access", "secret", ClientOptions{
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 2 * time.Millisecond,
+	})
+
+	thingName := "thing"
+
+	_, err := n.GETCreateThing(context.Background(), &GETCreateThingRequestQuery{ThingName: &thingName})
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	if calls != 1 {
+		t.Errorf("expected the create operation to be called once, got %d calls", calls)
+	}
+}
@@ -1,407 +1,321 @@
 package sdk
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"unicode"
 
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper/oas"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 )
 
-// generate converter that convert openapi.json schema to terraform type
-func Gen_ConvertOAStoTFTypes(propreties *base.Schema, openapiType, format, resourceName string) (s, m, convertValueWithNull, possibleTypes, convertValueWithNullInEmptyArrCase string) {
-
-	for name, propSchema := range propreties.Properties.FromNewest() {
-		switch propSchema.Schema().Type[0] {
-		case "string":
-			s = s + fmt.Sprintf(`
-			if data["%[2]s"] != nil {
-				dto.%[1]s = types.StringValue(data["%[2]s"].(string))
-			}`, ToPascalCase(name), PascalToSnakeCase(CamelToPascalCase(name))) + "\n"
-			m = m + fmt.Sprintf("%[1]s         types.String `tfsdk:\"%[2]s\"`", ToPascalCase(name), PascalToSnakeCase(name)) + "\n"
-
-		case "integer":
-			switch propSchema.Schema().Format {
-			case "int64":
-				s = s + fmt.Sprintf(`
-				if data["%[2]s"] != nil {
-					dto.%[1]s = types.Int64Value(data["%[2]s"].(int64))
-				}`, ToPascalCase(name), PascalToSnakeCase(CamelToPascalCase(name))) + "\n"
-				m = m + fmt.Sprintf("%[1]s         types.Int64`tfsdk:\"%[2]s\"`", ToPascalCase(name), PascalToSnakeCase(name)) + "\n"
-
-			case "int32":
-				s = s + fmt.Sprintf(`
-				if data["%[2]s"] != nil {
-					dto.%[1]s = types.Int32Value(data["%[2]s"].(int32))
-				}`, ToPascalCase(name), PascalToSnakeCase(CamelToPascalCase(name))) + "\n"
-				m = m + fmt.Sprintf("%[1]s         types.Int32`tfsdk:\"%[2]s\"`", ToPascalCase(name), PascalToSnakeCase(name)) + "\n"
-			}
-
-		case "number":
-			s = s + fmt.Sprintf(`
-			if data["%[2]s"] != nil {
-				dto.%[1]s = types.Float64Value(data["%[2]s"].(float64))
-			}`, ToPascalCase(name), PascalToSnakeCase(CamelToPascalCase(name))) + "\n"
-			m = m + fmt.Sprintf("%[1]s         types.Float64 `tfsdk:\"%[2]s\"`", ToPascalCase(name), PascalToSnakeCase(name)) + "\n"
-
-		case "boolean":
-			s = s + fmt.Sprintf(`
-			if data["%[2]s"] != nil {
-				dto.%[1]s = types.BoolValue(data["%[2]s"].(bool))
-			}`, ToPascalCase(name), PascalToSnakeCase(CamelToPascalCase(name))) + "\n"
-			m = m + fmt.Sprintf("%[1]s         types.Bool `tfsdk:\"%[2]s\"`", ToPascalCase(name), PascalToSnakeCase(name)) + "\n"
-
-		case "array":
-
-			switch propSchema.Schema().Items.A.Schema().Type[0] {
-			case "object":
-				s = s + fmt.Sprintf(`
-				if data["%[2]s"] != nil {
-					temp%[1]s := data["%[2]s"].([]interface{})
-					dto.%[1]s = diagOff(types.ListValueFrom, ctx, types.ListType{ElemType:
-						%[3]s
-					}}.ElementType(), temp%[1]s)
-				}`, CamelToPascalCase(name), PascalToSnakeCase(CamelToPascalCase(name)), GenArray(propSchema.Schema().Items.A.Schema(), name)+"\n")
-
-			case "string":
-				s = s + fmt.Sprintf(`
-				if data["%[2]s"] != nil {
-					temp%[1]s := data["%[2]s"].([]interface{})
-					dto.%[1]s = diagOff(types.ListValueFrom, ctx, types.ListType{ElemType: types.StringType}.ElementType(), temp%[1]s)
-				}`, ToPascalCase(PascalToSnakeCase(name)), PascalToSnakeCase(CamelToPascalCase(name))) + "\n"
-
-			case "boolean":
-				s = s + fmt.Sprintf(`
-				if data["%[2]s"] != nil {
-					temp%[1]s := data["%[2]s"].([]interface{})
-					dto.%[1]s = diagOff(types.ListValueFrom, ctx, types.ListType{ElemType: types.BoolType}.ElementType(), temp%[1]s)
-				}`, ToPascalCase(PascalToSnakeCase(name)), PascalToSnakeCase(CamelToPascalCase(name))) + "\n"
-
-			case "integer":
-				switch propSchema.Schema().Items.A.Schema().Format {
-				case "int64":
-					s = s + fmt.Sprintf(`
-					if data["%[2]s"] != nil {
-						temp%[1]s := data["%[2]s"].([]interface{})
-						dto.%[1]s = diagOff(types.ListValueFrom, ctx, types.ListType{ElemType: types.Int64Type}.ElementType(), temp%[1]s)
-					}`, ToPascalCase(PascalToSnakeCase(name)), PascalToSnakeCase(CamelToPascalCase(name))) + "\n"
-
-				case "int32":
-					s = s + fmt.Sprintf(`
-					if data["%[2]s"] != nil {
-						temp%[1]s := data["%[2]s"].([]interface{})
-						dto.%[1]s = diagOff(types.ListValueFrom, ctx, types.ListType{ElemType: types.Int32Type}.ElementType(), temp%[1]s)
-					}`, ToPascalCase(PascalToSnakeCase(name)), PascalToSnakeCase(CamelToPascalCase(name))) + "\n"
-				}
-
-			case "number":
-				s = s + fmt.Sprintf(`
-				if data["%[2]s"] != nil {
-					temp%[1]s := data["%[2]s"].([]interface{})
-					dto.%[1]s = diagOff(types.ListValueFrom, ctx, types.ListType{ElemType: types.Float64Type}.ElementType(), temp%[1]s)
-				}`, ToPascalCase(PascalToSnakeCase(name)), PascalToSnakeCase(CamelToPascalCase(name))) + "\n"
-			}
-
-			m = m + fmt.Sprintf("%[1]s         types.List `tfsdk:\"%[2]s\"`", CamelToPascalCase(name), PascalToSnakeCase(name)) + "\n"
-
-		case "object":
-			s = s + fmt.Sprintf(`
-			if data["%[2]s"] != nil {
-				temp%[1]s := data["%[2]s"].(map[string]interface{})
-
-				allFields := []string{
-					%[5]s
-				}
-
-				convertedMap := make(map[string]interface{})
-				for _, field := range allFields {
-					if val, ok := temp%[1]s[field]; ok {
-						convertedMap[field] = val
-					}
-				}
-
-				convertedTemp%[1]s, err := convertToObject_%[4]s(ctx, convertedMap)
-				if err != nil {
-					return nil, err
-				}
-
-				dto.%[1]s = diagOff(types.ObjectValueFrom, ctx, types.ObjectType{AttrTypes: map[string]attr.Type{
-					%[3]s
-				}}.AttributeTypes(), convertedTemp%[1]s)
-			}`, CamelToPascalCase(name), PascalToSnakeCase(CamelToPascalCase(name)), GenObject(propSchema.Schema(), name), resourceName, GenAllFields(propSchema.Schema())) + "\n"
-
-			m = m + fmt.Sprintf("%[1]s         types.Object `tfsdk:\"%[2]s\"`", CamelToPascalCase(name), PascalToSnakeCase(name)) + "\n"
-			possibleTypes = possibleTypes + GenObject(propSchema.Schema(), name) + "\n"
-			convertValueWithNull, convertValueWithNullInEmptyArrCase = GenConvertValueWithNull(propSchema.Schema(), name)
+// generate converter that convert openapi.json schema to terraform type. Schema composition is resolved the same way as the mapper,
+// so the converted types match the attributes of the provider code spec.
+func Gen_ConvertOAStoTFTypes(proxy *base.SchemaProxy, resourceName string) (s, m, attrTypes, attrConverters, converters string, err error) {
+	propreties, schemaErr := oas.ResolveSchemaProxy(proxy)
+	if schemaErr != nil {
+		return "", "", "", "", "", fmt.Errorf("error resolving response schema: %w", schemaErr)
+	}
+
+	if getSchemaType(propreties) == "" {
+		return "", "", "", "", "", errors.New("response schema has no type or properties")
+	}
+
+	c := &refreshConverters{
+		resourceName: resourceName,
+		visiting:     map[any]bool{schemaKey(proxy): true},
+	}
+
+	for name, propProxy := range propreties.Properties.FromNewest() {
+		schema := resolveSchema(propProxy)
+
+		fieldName := CamelToPascalCase(name)
+		switch getRefreshType(schema) {
+		case "string", "boolean", "int32", "int64", "number":
+			fieldName = ToPascalCase(name)
 		}
+
+		converter := c.converter(propProxy, CamelToPascalCase(name))
+
+		s = s + fmt.Sprintf(`
+		converted%[1]s, err := %[3]s(ctx, data["%[2]s"])
+		if err != nil {
+			return nil, fmt.Errorf("error converting field %[2]s: %%w", err)
+		}
+		dto.%[1]s = converted%[1]s.(%[4]s)`, fieldName, PascalToSnakeCase(CamelToPascalCase(name)), converter, getModelType(schema)) + "\n"
+
+		m = m + fmt.Sprintf("%[1]s         %[2]s `tfsdk:\"%[3]s\"`", fieldName, getModelType(schema), PascalToSnakeCase(name)) + "\n"
+		attrTypes = attrTypes + fmt.Sprintf(`"%[1]s": %[2]s,`, PascalToSnakeCase(CamelToPascalCase(name)), c.attrType(propProxy)) + "\n"
+		attrConverters = attrConverters + fmt.Sprintf(`"%[1]s": %[2]s,`, PascalToSnakeCase(CamelToPascalCase(name)), converter) + "\n"
 	}
 
-	return s, m, convertValueWithNull, possibleTypes, convertValueWithNullInEmptyArrCase
+	return s, m, attrTypes, attrConverters, c.functions.String(), nil
 }
 
-func PascalToSnakeCase(s string) string {
-	var result []rune
-	for i, r := range s {
-		if i > 0 && r >= 'A' && r <= 'Z' {
-			result = append(result, '_')
+// refreshConverters generates a converter function for every nested object, list and map schema of a response, so values of
+// any depth are converted to their Terraform types. Primitive values are converted by the helpers of the client file.
+type refreshConverters struct {
+	resourceName string
+	functions    strings.Builder
+
+	// Schemas that are being generated, a recursive schema can't be represented as a Terraform type
+	// so the recursion is converted as a JSON string instead
+	visiting map[any]bool
+}
+
+// converter returns the name of the function that converts a response value of the schema, generating it for nested schemas.
+func (c *refreshConverters) converter(proxy *base.SchemaProxy, name string) string {
+	schema := resolveSchema(proxy)
+	if schema == nil || c.visiting[schemaKey(proxy)] {
+		return "convertStringValue"
+	}
+
+	functionName := fmt.Sprintf("convert_%s_%s", c.resourceName, name)
+
+	switch getRefreshType(schema) {
+	case "boolean":
+		return "convertBoolValue"
+
+	case "int32":
+		return "convertInt32Value"
+
+	case "int64":
+		return "convertInt64Value"
+
+	case "number":
+		return "convertFloat64Value"
+
+	case "array":
+		items := getItemsProxy(schema)
+		elemConverter := c.converter(items, name+"Item")
+
+		c.functions.WriteString(fmt.Sprintf(`
+		func %[1]s(ctx context.Context, value interface{}) (attr.Value, error) {
+			return convertListValue(ctx, value, %[2]s, %[3]s)
+		}`, functionName, c.attrType(items), elemConverter) + "\n")
+
+	case "map":
+		values := schema.AdditionalProperties.A
+		elemConverter := c.converter(values, name+"Value")
+
+		c.functions.WriteString(fmt.Sprintf(`
+		func %[1]s(ctx context.Context, value interface{}) (attr.Value, error) {
+			return convertMapValue(ctx, value, %[2]s, %[3]s)
+		}`, functionName, c.attrType(values), elemConverter) + "\n")
+
+	case "object":
+		key := schemaKey(proxy)
+		c.visiting[key] = true
+		defer delete(c.visiting, key)
+
+		var attrTypes, converters string
+		for n, propProxy := range schema.Properties.FromOldest() {
+			attrTypes = attrTypes + fmt.Sprintf(`"%[1]s": %[2]s,`, PascalToSnakeCase(CamelToPascalCase(n)), c.attrType(propProxy)) + "\n"
+			converters = converters + fmt.Sprintf(`"%[1]s": %[2]s,`, PascalToSnakeCase(CamelToPascalCase(n)), c.converter(propProxy, name+CamelToPascalCase(n))) + "\n"
 		}
-		result = append(result, r)
+
+		c.functions.WriteString(fmt.Sprintf(`
+		func %[1]s(ctx context.Context, value interface{}) (attr.Value, error) {
+			return convertObjectValue(ctx, value, map[string]attr.Type{
+				%[2]s
+			}, map[string]attrConverter{
+				%[3]s
+			})
+		}`, functionName, attrTypes, converters) + "\n")
+
+	default:
+		return "convertStringValue"
 	}
-	return strings.ToLower(string(result))
+
+	return functionName
 }
 
-func CamelToPascalCase(s string) string {
-	if len(s) == 0 {
-		return s
+// attrType returns the Terraform type of the schema, as Go code.
+func (c *refreshConverters) attrType(proxy *base.SchemaProxy) string {
+	schema := resolveSchema(proxy)
+	if schema == nil || c.visiting[schemaKey(proxy)] {
+		return "types.StringType"
 	}
-	r := []rune(s)
-	r[0] = unicode.ToUpper(r[0])
-	return string(r)
-}
 
-func GenArray(d *base.Schema, pName string) string {
-	var r string
-	var s string
-	var t string
+	switch getRefreshType(schema) {
+	case "boolean":
+		return "types.BoolType"
 
-	for n, schema := range d.Properties.FromNewest() {
+	case "int32":
+		return "types.Int32Type"
 
-		switch schema.Schema().Type[0] {
-		case "string":
-			t = t + fmt.Sprintf(`"%[1]s": types.StringType,`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
+	case "int64":
+		return "types.Int64Type"
 
-		case "boolean":
-			t = t + fmt.Sprintf(`"%[1]s": types.BoolType,`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
+	case "number":
+		return "types.Float64Type"
 
-		case "integer":
+	case "array":
+		return fmt.Sprintf("types.ListType{ElemType: %s}", c.attrType(getItemsProxy(schema)))
 
-			switch schema.Schema().Format {
-			case "int64":
-				t = t + fmt.Sprintf(`"%[1]s": types.Int64Type,`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
+	case "map":
+		return fmt.Sprintf("types.MapType{ElemType: %s}", c.attrType(schema.AdditionalProperties.A))
 
-			case "int32":
-				t = t + fmt.Sprintf(`"%[1]s": types.Int32Type,`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
+	case "object":
+		key := schemaKey(proxy)
+		c.visiting[key] = true
+		defer delete(c.visiting, key)
 
-			}
+		var attrTypes string
+		for n, propProxy := range schema.Properties.FromOldest() {
+			attrTypes = attrTypes + fmt.Sprintf(`"%[1]s": %[2]s,`, PascalToSnakeCase(CamelToPascalCase(n)), c.attrType(propProxy)) + "\n"
+		}
 
-		case "number":
-			t = t + fmt.Sprintf(`"%[1]s": types.Float64Type,`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
+		return fmt.Sprintf(`types.ObjectType{AttrTypes: map[string]attr.Type{
+			%s
+		}}`, attrTypes)
+	}
 
-		case "array":
+	return "types.StringType"
+}
 
-			switch schema.Schema().Items.A.Schema().Type[0] {
-			case "object":
-				t = t + fmt.Sprintf(`
-				"%[1]s": types.ListType{ElemType:
-					types.ObjectType{AttrTypes: map[string]attr.Type{
-						%[2]s
-					},
-				}},`, PascalToSnakeCase(CamelToPascalCase(n)), GenObject(schema.Schema().Items.A.Schema(), n)) + "\n"
+// Helper function to find the type of the model field of a response property
+func getModelType(schema *base.Schema) string {
+	switch getRefreshType(schema) {
+	case "boolean":
+		return "types.Bool"
+	case "int32":
+		return "types.Int32"
+	case "int64":
+		return "types.Int64"
+	case "number":
+		return "types.Float64"
+	case "array":
+		return "types.List"
+	case "map":
+		return "types.Map"
+	case "object":
+		return "types.Object"
+	}
 
-			case "string":
-				t = t + fmt.Sprintf(`"%[1]s": types.ListType{ElemType: types.StringType},`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
+	return "types.String"
+}
 
-			case "boolean":
-				t = t + fmt.Sprintf(`"%[1]s": types.ListType{ElemType: types.BoolType},`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
+// Helper function to find the type of a response schema to convert, integers are split by format and
+// objects with only `additionalProperties` are converted as maps
+func getRefreshType(schema *base.Schema) string {
+	if schema == nil {
+		return ""
+	}
 
-			case "integer":
-				switch schema.Schema().Format {
-				case "int64":
-					t = t + fmt.Sprintf(`"%[1]s": types.Int64Type,`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
+	switch t := getSchemaType(schema); t {
+	case "integer":
+		if schema.Format == "int32" {
+			return "int32"
+		}
+		return "int64"
 
-				case "int32":
-					t = t + fmt.Sprintf(`"%[1]s": types.Int32Type,`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
-				}
+	case "object":
+		hasProperties := schema.Properties != nil && schema.Properties.Len() > 0
+		if !hasProperties && schema.AdditionalProperties != nil && schema.AdditionalProperties.IsA() {
+			return "map"
+		}
+		return t
 
-			case "number":
-				t = t + fmt.Sprintf(`"%[1]s": types.ListType{ElemType: types.Float64Type},`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
-			}
+	default:
+		return t
+	}
+}
 
-		case "object":
-			s = s + fmt.Sprintf(`
-			"%[1]s": types.ObjectType{AttrTypes: map[string]attr.Type{
-				%[2]s
-			}},`, PascalToSnakeCase(CamelToPascalCase(n)), GenObject(schema.Schema(), n)) + "\n"
-		}
+// Helper function to build a nested schema with its schema composition resolved, like the mapper. Schemas that the mapper can't
+// resolve are skipped from the provider code spec, so they fall back to the schema as written, which is converted as a string.
+func resolveSchema(proxy *base.SchemaProxy) *base.Schema {
+	if proxy == nil {
+		return nil
 	}
 
-	r = r + fmt.Sprintf(`
-	types.ObjectType{AttrTypes: map[string]attr.Type{
-		%[1]s
-		%[2]s
-	},`, s, t)
+	schema, err := oas.ResolveSchemaProxy(proxy)
+	if err != nil {
+		return proxy.Schema()
+	}
 
-	return r
+	return schema
 }
 
-func GenObject(d *base.Schema, pName string) string {
-	var s string
-
-	for n, schema := range d.Properties.FromNewest() {
-		switch schema.Schema().Type[0] {
-		case "string":
-			s = s + fmt.Sprintf(`"%[1]s": types.StringType,`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
-
-		case "boolean":
-			s = s + fmt.Sprintf(`"%[1]s": types.BoolType,`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
-
-		case "integer":
-			switch schema.Schema().Format {
-			case "int64":
-				s = s + fmt.Sprintf(`"%[1]s": types.Int64Type,`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
-
-			case "int32":
-				s = s + fmt.Sprintf(`"%[1]s": types.Int32Type,`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
-			}
-
-		case "number":
-			s = s + fmt.Sprintf(`"%[1]s": types.Float64Type,`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
-
-		case "object":
-			// In case of `properties: { }`
-			if schema.Schema().Properties == nil {
-				s = s + fmt.Sprintf(`
-				"%[1]s": types.ObjectType{AttrTypes: map[string]attr.Type{
-				}},`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
-			} else {
-				s = s + fmt.Sprintf(`
-				"%[1]s": types.ObjectType{AttrTypes: map[string]attr.Type{
-					%[2]s
-				}},`, PascalToSnakeCase(CamelToPascalCase(n)), GenObject(schema.Schema(), n)) + "\n"
-			}
-
-		case "array":
-			if schema.Schema().Items.A.Schema().Type[0] == "object" {
-				s = s + fmt.Sprintf(`
-			"%[1]s": types.ListType{ElemType:
-				%[2]s
-			}},`, PascalToSnakeCase(CamelToPascalCase(n)), GenArray(schema.Schema().Items.A.Schema(), n)) + "\n"
-			} else {
-				switch schema.Schema().Items.A.Schema().Type[0] {
-				case "string":
-					s = s + fmt.Sprintf(`"%[1]s": types.ListType{ElemType: types.StringType},`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
-
-				case "boolean":
-					s = s + fmt.Sprintf(`"%[1]s": types.ListType{ElemType: types.BoolType},`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
-
-				case "integer":
-					switch schema.Schema().Items.A.Schema().Format {
-					case "int64":
-						s = s + fmt.Sprintf(`"%[1]s": types.ListType{ElemType: types.Int64Type},`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
-
-					case "int32":
-						s = s + fmt.Sprintf(`"%[1]s": types.ListType{ElemType: types.Int32Type},`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
-					}
-
-				case "number":
-					s = s + fmt.Sprintf(`"%[1]s": types.ListType{ElemType: types.Float64Type},`, PascalToSnakeCase(CamelToPascalCase(n))) + "\n"
-				}
-			}
+// Helper function to identify a schema while generating converters, to find recursive references. A schema can only recur
+// through a reference, so references are identified by the referenced location, as every reference is built as a separate
+// high-level schema, and inline schemas by their proxy.
+func schemaKey(proxy *base.SchemaProxy) any {
+	if proxy.IsReference() {
+		return proxy.GetReference()
+	}
+
+	return proxy
+}
+
+// getMapPaths returns the locations of the maps of a response schema, as JSON pointers with the property names of the response
+// and "*" for any array index or map key. The keys of a map are user data rather than property names, so the generated method
+// leaves them as they are while converting the other keys of the response to snake_case.
+func getMapPaths(proxy *base.SchemaProxy) []string {
+	var paths []string
+	collectMapPaths(proxy, "", map[any]bool{}, &paths)
+	sort.Strings(paths)
+
+	return paths
+}
+
+func collectMapPaths(proxy *base.SchemaProxy, path string, visiting map[any]bool, paths *[]string) {
+	schema := resolveSchema(proxy)
+
+	// Recursive schemas are converted as JSON strings, like the converters
+	if schema == nil || visiting[schemaKey(proxy)] {
+		return
+	}
+
+	key := schemaKey(proxy)
+	visiting[key] = true
+	defer delete(visiting, key)
+
+	switch getRefreshType(schema) {
+	case "array":
+		collectMapPaths(getItemsProxy(schema), path+"/*", visiting, paths)
+
+	case "map":
+		*paths = append(*paths, path)
+		collectMapPaths(schema.AdditionalProperties.A, path+"/*", visiting, paths)
+
+	case "object":
+		for name, propProxy := range schema.Properties.FromOldest() {
+			collectMapPaths(propProxy, path+"/"+jsonPointerEscaper.Replace(name), visiting, paths)
 		}
 	}
-	return s
 }
 
-func GenAllFields(d *base.Schema) string {
-	var s string
+// jsonPointerEscaper escapes a property name as a JSON pointer token, the same way as convertKeys of the client file
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
 
-	for n := range d.Properties.FromNewest() {
-		s = s + fmt.Sprintf(`"%[1]s",`, PascalToSnakeCase(n)) + "\n"
+// Helper function to find the items schema of an array, which is nil if the items are missing or a boolean
+func getItemsProxy(schema *base.Schema) *base.SchemaProxy {
+	if schema.Items == nil || !schema.Items.IsA() {
+		return nil
 	}
-	return s
+
+	return schema.Items.A
 }
 
-func GenConvertValueWithNull(d *base.Schema, pName string) (s string, v string) {
-	for n, schema := range d.Properties.FromNewest() {
-		switch schema.Schema().Type[0] {
-		case "array":
-			// in case of empty array, logic assumes it non-null
-			// so explicitly check it
-			v = v + fmt.Sprintf(`
-			if field == "%[1]s" && len(value.([]interface{})) == 0 {
-				listV := types.ListNull(types.ObjectNull(map[string]attr.Type{
-					%[2]s
-				}).Type(ctx))
-				attrValues[field] = listV
-				continue
-			}`, PascalToSnakeCase(n), GenObject(schema.Schema().Items.A.Schema(), n)) + "\n"
-
-			switch schema.Schema().Items.A.Schema().Type[0] {
-			case "object":
-				s = s + fmt.Sprintf(`
-				if field == "%[1]s" {
-					listV := types.ListNull(types.ObjectNull(map[string]attr.Type{
-						%[2]s
-					}).Type(ctx))
-					attrValues[field] = listV
-					continue
-				}`, PascalToSnakeCase(n), GenObject(schema.Schema().Items.A.Schema(), n)) + "\n"
-
-			case "string":
-				s = s + fmt.Sprintf(`
-				if field == "%[1]s" {
-					listV := types.ListNull(types.StringNull().Type(ctx))
-					attrValues[field] = listV
-					continue
-				}`, PascalToSnakeCase(n)) + "\n"
-
-			case "boolean":
-				s = s + fmt.Sprintf(`
-					if field == "%[1]s" {
-						listV := types.ListNull(types.BoolNull().Type(ctx))
-						attrValues[field] = listV
-						continue
-					}`, PascalToSnakeCase(n)) + "\n"
-
-			case "integer":
-				switch schema.Schema().Items.A.Schema().Format {
-				case "int64":
-					s = s + fmt.Sprintf(`
-					if field == "%[1]s" {
-						listV := types.ListNull(types.Int64Null().Type(ctx))
-						attrValues[field] = listV
-						continue
-					}`, PascalToSnakeCase(n)) + "\n"
-
-				case "int32":
-					s = s + fmt.Sprintf(`
-					if field == "%[1]s" {
-						listV := types.ListNull(types.Int32Null().Type(ctx))
-						attrValues[field] = listV
-						continue
-					}`, PascalToSnakeCase(n)) + "\n"
-				}
-
-			case "number":
-				s = s + fmt.Sprintf(`
-				if field == "%[1]s" {
-					listV := types.ListNull(types.Float64Null().Type(ctx))
-					attrValues[field] = listV
-					continue
-				}`, PascalToSnakeCase(n)) + "\n"
-			}
-
-		case "object":
-			// In case of `properties: { }`
-			if schema.Schema().Properties == nil {
-				s = s + fmt.Sprintf(`
-				if field == "%[1]s" {
-					listV := types.ObjectNull(map[string]attr.Type{})
-					attrValues[field] = listV
-					continue
-				}`, PascalToSnakeCase(n)) + "\n"
-			} else {
-				s = s + fmt.Sprintf(`
-				if field == "%[1]s" {
-					listV := types.ObjectNull(map[string]attr.Type{
-						%[2]s
-					})
-					attrValues[field] = listV
-					continue
-				}`, PascalToSnakeCase(n), GenObject(schema.Schema(), n)) + "\n"
-			}
+func PascalToSnakeCase(s string) string {
+	var result []rune
+	for i, r := range s {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			result = append(result, '_')
 		}
+		result = append(result, r)
 	}
+	return strings.ToLower(string(result))
+}
 
-	return s, v
+func CamelToPascalCase(s string) string {
+	if len(s) == 0 {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
 }
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package generator generates a Provider Code Specification and an Ncloud SDK layer from a YAML generator config and an
+// OpenAPI 3.x specification. It's the library behind the `generate` command, for embedding the generator in other tools.
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"path/filepath"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/mapper"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/internal/sdk"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/spec"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/index"
+)
+
+// Options configures a Generate run.
+type Options struct {
+	// Config is the YAML generator config.
+	Config []byte
+
+	// ConfigDir is the directory that relative paths in the generator config are relative to, usually the directory of the
+	// generator config file. Defaults to the root of the Writer.
+	ConfigDir string
+
+	// OpenAPISpec is the OpenAPI 3.x specification, in JSON or YAML.
+	OpenAPISpec []byte
+
+	// ProviderCodeSpecPath is the name the Provider Code Specification is written to. If empty, it's only returned in the Result.
+	ProviderCodeSpecPath string
+
+	// SDK configures the Ncloud SDK layer, overriding the `sdk` section of the generator config.
+	SDK SDKOptions
+
+	// Writer writes the generated files. Required.
+	Writer Writer
+
+	// Logger logs warnings and mapping details. Defaults to discarding all logs.
+	Logger *slog.Logger
+}
+
+// SDKOptions configures the Ncloud SDK layer. Empty and nil fields fall back to the `sdk` section of the generator config.
+type SDKOptions struct {
+	// All generates the SDK for every operation, instead of only the operations referenced by the generator config.
+	All bool
+
+	// OutputDir is the directory the SDK is generated in. Defaults to `ncloudsdk`, next to the Provider Code Specification.
+	OutputDir string
+
+	// PackageName is the Go package name of the SDK. Defaults to `ncloudsdk`.
+	PackageName string
+
+	// ImportPath is the Go import path of the SDK, used as the module path of its go.mod.
+	ImportPath string
+
+	// GoMod emits a standalone go.mod in the output directory, if true. Requires an import path. If nil, the `go_mod` property of the
+	// generator config is used, so it can be turned off by setting it to false.
+	GoMod *bool
+}
+
+// Result is the output of a Generate run.
+type Result struct {
+	// ProviderCodeSpec is the Provider Code Specification, as JSON.
+	ProviderCodeSpec []byte
+
+	// Files are the names of every file written to the Writer, in order.
+	Files []string
+}
+
+// NcloudSpecification is the Provider Code Specification, with the request and response details of the Ncloud API.
+type NcloudSpecification struct {
+	spec.Specification
+	Resources   []mapper.DetailResourceInfo   `json:"resources"`
+	DataSources []mapper.DetailDataSourceInfo `json:"datasources"`
+	Provider    *mapper.ProviderWithEndpoint  `json:"provider"`
+}
+
+// Generate generates the Ncloud SDK layer and the Provider Code Specification, and writes them to the Writer of opts.
+func Generate(ctx context.Context, opts Options) (*Result, error) {
+	if opts.Writer == nil {
+		return nil, errors.New("writer is required")
+	}
+
+	logger := opts.Logger
+	if logger == nil {
+		logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+
+	w := &recordingWriter{Writer: opts.Writer}
+
+	// 1. Parse generator config
+	cfg, err := config.ParseConfig(opts.Config)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing generator config: %w", err)
+	}
+
+	// 2. Parse OpenAPI spec
+	doc, err := libopenapi.NewDocument(opts.OpenAPISpec)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing OpenAPI spec: %w", err)
+	}
+
+	// 3. Build out the OpenAPI model, this will recursively load all local + remote references into one cohesive model
+	model, errs := doc.BuildV3Model()
+
+	// 4. Log circular references as warnings and fail on any other model building errors
+	var errResult error
+	for _, err := range errs {
+		if rslvErr, ok := err.(*index.ResolvingError); ok {
+			logger.Warn(
+				"circular reference found in OpenAPI spec",
+				"circular_ref", rslvErr.CircularReference.GenerateJourneyPath())
+			continue
+		}
+
+		errResult = errors.Join(errResult, err)
+	}
+	if errResult != nil {
+		return nil, fmt.Errorf("error building OpenAPI 3.x model: %w", errResult)
+	}
+
+	// 4-1. Validate the generator config against the OpenAPI model, before any mapping
+	if err = mapper.ValidateConfig(model.Model, *cfg); err != nil {
+		return nil, fmt.Errorf("generator config validation error(s):\n%w", err)
+	}
+
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// 5. Find TF resources, data sources and provider in OAS
+	dora := explorer.NewConfigExplorer(model.Model, *cfg)
+
+	explorerResources, err := dora.FindResources()
+	if err != nil {
+		return nil, fmt.Errorf("error finding resource(s): %w", err)
+	}
+
+	explorerDataSources, err := dora.FindDataSources()
+	if err != nil {
+		return nil, fmt.Errorf("error finding data source(s): %w", err)
+	}
+
+	explorerProvider, err := dora.FindProvider()
+	if err != nil {
+		return nil, fmt.Errorf("error finding provider: %w", err)
+	}
+
+	// 6. Generate Ncloud SDK layer for the operations referenced by the generator config
+	sdkOptions := opts.sdkOptions(cfg.SDK)
+	sdkOptions.Operations = sdk.OperationsOf(explorerResources, explorerDataSources)
+	if err = sdk.Generate(model, cfg, sdkOptions, w); err != nil {
+		return nil, fmt.Errorf("error generating Ncloud SDK layer: %w", err)
+	}
+
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// 7. Generate provider code spec w/ config, referencing the validators of the generated SDK package
+	cfg.SDK.ImportPath = sdkOptions.ImportPath
+	cfg.SDK.Package = sdkOptions.PackageName
+	if cfg.SDK.Package == "" {
+		cfg.SDK.Package = sdk.DefaultPackageName
+	}
+
+	providerCodeSpec, err := generateProviderCodeSpec(logger, explorerResources, explorerDataSources, explorerProvider, *cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// 8. Use provider code spec to create JSON
+	bytes, err := json.MarshalIndent(providerCodeSpec, "", "\t")
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling provider code spec to JSON: %w", err)
+	}
+
+	// 9. Log a warning if the provider code spec is not valid based on the JSON schema
+	err = spec.Validate(ctx, bytes)
+	if err != nil {
+		logger.Warn(
+			"generated provider code spec failed validation",
+			"validation_msg", err)
+	}
+
+	// 10. Output provider code spec
+	if opts.ProviderCodeSpecPath != "" {
+		err = w.WriteFile(opts.ProviderCodeSpecPath, bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error writing provider code spec to output: %w", err)
+		}
+	}
+
+	return &Result{
+		ProviderCodeSpec: bytes,
+		Files:            w.names,
+	}, nil
+}
+
+// sdkOptions returns the options of the Ncloud SDK layer, from the options and the generator config. Options take precedence, and
+// relative paths in the generator config are relative to ConfigDir, so the output doesn't depend on the working directory.
+func (o Options) sdkOptions(cfg config.SDK) sdk.Options {
+	opts := sdk.Options{
+		All:         o.SDK.All,
+		OutputDir:   o.SDK.OutputDir,
+		PackageName: cfg.Package,
+		ImportPath:  cfg.ImportPath,
+		GoMod:       cfg.GoMod,
+	}
+
+	if opts.OutputDir == "" {
+		switch {
+		case cfg.OutputDir == "":
+			opts.OutputDir = path.Join(path.Dir(filepath.ToSlash(o.ProviderCodeSpecPath)), sdk.DefaultOutputDir)
+		case filepath.IsAbs(cfg.OutputDir):
+			opts.OutputDir = cfg.OutputDir
+		default:
+			opts.OutputDir = filepath.Join(o.ConfigDir, cfg.OutputDir)
+		}
+	}
+
+	if o.SDK.PackageName != "" {
+		opts.PackageName = o.SDK.PackageName
+	}
+
+	if o.SDK.ImportPath != "" {
+		opts.ImportPath = o.SDK.ImportPath
+	}
+
+	if o.SDK.GoMod != nil {
+		opts.GoMod = *o.SDK.GoMod
+	}
+
+	return opts
+}
+
+func generateProviderCodeSpec(logger *slog.Logger, explorerResources map[string]explorer.Resource, explorerDataSources map[string]explorer.DataSource, explorerProvider explorer.Provider, cfg config.Config) (*NcloudSpecification, error) {
+	// 1. Use TF info to generate provider code spec for resources
+	resourceMapper := mapper.NewResourceMapper(explorerResources, cfg)
+	resourcesIR, err := resourceMapper.MapToIR(logger)
+	if err != nil {
+		return nil, fmt.Errorf("error generating provider code spec for resources: %w", err)
+	}
+
+	// 2. Use TF info to generate provider code spec for data sources
+	dataSourceMapper := mapper.NewDataSourceMapper(explorerDataSources, cfg)
+	dataSourcesIR, err := dataSourceMapper.MapToIR(logger)
+	if err != nil {
+		return nil, fmt.Errorf("error generating provider code spec for data sources: %w", err)
+	}
+
+	// 3. Use TF info to generate provider code spec for provider
+	providerMapper := mapper.NewProviderMapper(explorerProvider, cfg)
+	providerIR, err := providerMapper.MapToIR(logger)
+	if err != nil {
+		return nil, fmt.Errorf("error generating provider code spec for provider: %w", err)
+	}
+
+	return &NcloudSpecification{
+		Specification: spec.Specification{
+			Version: spec.Version0_1,
+		},
+		Provider:    providerIR,
+		Resources:   resourcesIR,
+		DataSources: dataSourcesIR,
+	}, nil
+}
@@ -0,0 +1,454 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-openapi/pkg/generator"
+	"github.com/google/go-cmp/cmp"
+)
+
+const testOpenAPISpec = `
+openapi: 3.0.1
+info:
+  title: example
+  version: "1"
+paths:
+  /things:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                name:
+                  type: string
+                size:
+                  type: integer
+                  multipleOf: 5
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  id:
+                    type: string
+                  name:
+                    type: string
+  /things/{id}:
+    get:
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  id:
+                    type: string
+                  name:
+                    type: string
+  /others:
+    get:
+      responses:
+        "200":
+          description: ok
+`
+
+const testConfig = `
+provider:
+  name: example
+  endpoint: https://example.com
+
+resources:
+  thing:
+    create:
+      path: /things
+      method: POST
+    read:
+      path: /things/{id}
+      method: GET
+    refresh_object_name: thing
+`
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts          generator.Options
+		expectedFiles []string
+		// expectedValidator is the definition of the SDK validator mapped from `multipleOf`, if any
+		expectedValidator string
+	}{
+		"defaults": {
+			opts: generator.Options{
+				ProviderCodeSpecPath: "provider_code_spec.json",
+			},
+			expectedFiles: []string{
+				"ncloudsdk/.codegen/VERSION",
+				"ncloudsdk/client.go",
+				"ncloudsdk/validators.go",
+				"ncloudsdk/security.go",
+				"ncloudsdk/GET_things_id.go",
+				"ncloudsdk/POST_things.go",
+				"ncloudsdk/request_types.go",
+				"provider_code_spec.json",
+			},
+		},
+		"sdk next to provider code spec": {
+			opts: generator.Options{
+				ProviderCodeSpecPath: "out/provider_code_spec.json",
+			},
+			expectedFiles: []string{
+				"out/ncloudsdk/.codegen/VERSION",
+				"out/ncloudsdk/client.go",
+				"out/ncloudsdk/validators.go",
+				"out/ncloudsdk/security.go",
+				"out/ncloudsdk/GET_things_id.go",
+				"out/ncloudsdk/POST_things.go",
+				"out/ncloudsdk/request_types.go",
+				"out/provider_code_spec.json",
+			},
+		},
+		"sdk options": {
+			opts: generator.Options{
+				SDK: generator.SDKOptions{
+					All:         true,
+					OutputDir:   "internal/sdk",
+					PackageName: "sdk",
+					ImportPath:  "example.com/provider/internal/sdk",
+					GoMod:       pointer(true),
+				},
+			},
+			expectedFiles: []string{
+				"internal/sdk/.codegen/VERSION",
+				"internal/sdk/go.mod",
+				"internal/sdk/client.go",
+				"internal/sdk/validators.go",
+				"internal/sdk/security.go",
+				"internal/sdk/GET_others.go",
+				"internal/sdk/GET_things_id.go",
+				"internal/sdk/POST_things.go",
+				"internal/sdk/request_types.go",
+			},
+			expectedValidator: "sdk.MultipleOf(5)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			w := generator.NewMemoryWriter()
+
+			testCase.opts.Config = []byte(testConfig)
+			testCase.opts.OpenAPISpec = []byte(testOpenAPISpec)
+			testCase.opts.Writer = w
+
+			result, err := generator.Generate(context.Background(), testCase.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(result.Files, testCase.expectedFiles); diff != "" {
+				t.Errorf("unexpected difference in files: %s", diff)
+			}
+
+			gotValidator := bytes.Contains(result.ProviderCodeSpec, []byte("MultipleOf("))
+			if testCase.expectedValidator == "" && gotValidator {
+				t.Errorf("expected no SDK validator without an import path, got:\n%s", result.ProviderCodeSpec)
+			}
+			if testCase.expectedValidator != "" && !bytes.Contains(result.ProviderCodeSpec, []byte(testCase.expectedValidator)) {
+				t.Errorf("expected provider code spec to contain %q, got:\n%s", testCase.expectedValidator, result.ProviderCodeSpec)
+			}
+
+			if testCase.opts.ProviderCodeSpecPath != "" {
+				providerCodeSpec, err := w.ReadFile(testCase.opts.ProviderCodeSpecPath)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !bytes.Equal(providerCodeSpec, result.ProviderCodeSpec) {
+					t.Errorf("written provider code spec doesn't match the result")
+				}
+			}
+		})
+	}
+}
+
+func TestGenerate_SDKGoMod(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configGoMod   bool
+		optionsGoMod  *bool
+		expectedGoMod bool
+	}{
+		"config": {
+			configGoMod:   true,
+			expectedGoMod: true,
+		},
+		"config off": {
+			configGoMod:   false,
+			expectedGoMod: false,
+		},
+		"options on": {
+			configGoMod:   false,
+			optionsGoMod:  pointer(true),
+			expectedGoMod: true,
+		},
+		"options off overrides config": {
+			configGoMod:   true,
+			optionsGoMod:  pointer(false),
+			expectedGoMod: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := testConfig + fmt.Sprintf(`
+sdk:
+  import_path: example.com/ncloudsdk
+  go_mod: %t
+`, testCase.configGoMod)
+
+			w := generator.NewMemoryWriter()
+
+			_, err := generator.Generate(context.Background(), generator.Options{
+				Config:      []byte(config),
+				OpenAPISpec: []byte(testOpenAPISpec),
+				SDK: generator.SDKOptions{
+					GoMod: testCase.optionsGoMod,
+				},
+				Writer: w,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, err = w.ReadFile("ncloudsdk/go.mod")
+			if gotGoMod := err == nil; gotGoMod != testCase.expectedGoMod {
+				t.Errorf("expected go.mod to be emitted: %t, got: %t", testCase.expectedGoMod, gotGoMod)
+			}
+		})
+	}
+}
+
+// TestGenerate_ResponseSchemaComposition generates a resource whose responses are composed with allOf, which the Ncloud SDK layer
+// resolves the same way as the provider code spec, and a response without a type, which returns an error instead of panicking.
+func TestGenerate_ResponseSchemaComposition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		readResponse     string
+		expectedFile     string
+		expectedContent  string
+		expectedErrRegex string
+	}{
+		"allOf": {
+			readResponse: `
+                allOf:
+                  - $ref: '#/components/schemas/Thing'
+                  - type: object
+                    properties:
+                      owner:
+                        allOf:
+                          - $ref: '#/components/schemas/Owner'`,
+			expectedFile:    "ncloudsdk/GET_things_id.go",
+			expectedContent: `"owner": convert_GETThingsId_Owner,`,
+		},
+		"no type": {
+			readResponse: `
+                description: no type`,
+			expectedErrRegex: `error generating Ncloud SDK layer: .*response schema has no type or properties`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := `
+openapi: 3.0.1
+info:
+  title: example
+  version: "1"
+paths:
+  /things/{id}:
+    get:
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json;charset=UTF-8:
+              schema:` + testCase.readResponse + `
+components:
+  schemas:
+    Thing:
+      type: object
+      properties:
+        id:
+          type: string
+    Owner:
+      type: object
+      properties:
+        name:
+          type: string
+`
+
+			config := `
+provider:
+  name: example
+  endpoint: https://example.com
+
+datasources:
+  thing:
+    read:
+      path: /things/{id}
+      method: GET
+`
+
+			w := generator.NewMemoryWriter()
+
+			_, err := generator.Generate(context.Background(), generator.Options{
+				Config:      []byte(config),
+				OpenAPISpec: []byte(spec),
+				Writer:      w,
+			})
+
+			if testCase.expectedErrRegex != "" {
+				if err == nil || !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+					t.Fatalf("expected error to match %q, got %v", testCase.expectedErrRegex, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := w.ReadFile(testCase.expectedFile)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !bytes.Contains(got, []byte(testCase.expectedContent)) {
+				t.Errorf("expected %s to contain %q, got:\n%s", testCase.expectedFile, testCase.expectedContent, got)
+			}
+		})
+	}
+}
+
+func TestGenerate_Invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts             generator.Options
+		expectedErrRegex string
+	}{
+		"writer required": {
+			opts: generator.Options{
+				Config:      []byte(testConfig),
+				OpenAPISpec: []byte(testOpenAPISpec),
+			},
+			expectedErrRegex: `writer is required`,
+		},
+		"invalid config": {
+			opts: generator.Options{
+				Config:      []byte(`provider: {}`),
+				OpenAPISpec: []byte(testOpenAPISpec),
+				Writer:      generator.NewMemoryWriter(),
+			},
+			expectedErrRegex: `error parsing generator config`,
+		},
+		"invalid sdk package name": {
+			opts: generator.Options{
+				Config:      []byte(testConfig),
+				OpenAPISpec: []byte(testOpenAPISpec),
+				SDK: generator.SDKOptions{
+					PackageName: "ncloud-sdk",
+				},
+				Writer: generator.NewMemoryWriter(),
+			},
+			expectedErrRegex: `invalid package name "ncloud-sdk"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := generator.Generate(context.Background(), testCase.opts)
+			if err == nil {
+				t.Fatalf("Expected err to match %q, got nil", testCase.expectedErrRegex)
+			}
+
+			if !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+				t.Errorf("Expected error to match %q, got %q", testCase.expectedErrRegex, err.Error())
+			}
+		})
+	}
+}
+
+func TestMemoryWriter_ReadFile(t *testing.T) {
+	t.Parallel()
+
+	w := generator.NewMemoryWriter()
+
+	err := w.WriteFile("ncloudsdk/./client.go", []byte("package ncloudsdk"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data, err := w.ReadFile("ncloudsdk/client.go")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(string(data), "package ncloudsdk"); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err = w.ReadFile("ncloudsdk/security.go")
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected fs.ErrNotExist, got %v", err)
+	}
+}
+
+func pointer[T any](value T) *T {
+	return &value
+}
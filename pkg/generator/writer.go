@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// Writer writes the generated files. Names are slash-separated paths, which are either relative to the root of the writer or
+// absolute. Parent directories of a file are created by the writer.
+type Writer interface {
+	WriteFile(name string, data []byte) error
+}
+
+var (
+	_ Writer = DirWriter{}
+	_ Writer = (*MemoryWriter)(nil)
+)
+
+// DirWriter is a Writer for a directory on disk. An empty Dir writes relative names to the current working directory.
+type DirWriter struct {
+	Dir string
+}
+
+func (d DirWriter) WriteFile(name string, data []byte) error {
+	filename := filepath.FromSlash(name)
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(d.Dir, filename)
+	}
+
+	err := os.MkdirAll(filepath.Dir(filename), 0o755)
+	if err != nil {
+		return fmt.Errorf("error creating directory for %s: %w", name, err)
+	}
+
+	err = os.WriteFile(filename, data, 0o644)
+	if err != nil {
+		return fmt.Errorf("error writing %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// MemoryWriter is a Writer that keeps the files in memory, i.e. for tests or tooling that post-processes the output.
+type MemoryWriter struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+func NewMemoryWriter() *MemoryWriter {
+	return &MemoryWriter{
+		files: make(map[string][]byte),
+	}
+}
+
+func (m *MemoryWriter) WriteFile(name string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.files[path.Clean(name)] = append([]byte(nil), data...)
+
+	return nil
+}
+
+// ReadFile returns the contents of a written file, or an error wrapping fs.ErrNotExist if it wasn't written.
+func (m *MemoryWriter) ReadFile(name string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.files[path.Clean(name)]
+	if !ok {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrNotExist}
+	}
+
+	return append([]byte(nil), data...), nil
+}
+
+// Names returns the names of the written files, sorted.
+func (m *MemoryWriter) Names() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names := make([]string, 0, len(m.files))
+	for name := range m.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// recordingWriter records the names of the files written by the generator, for the Result.
+type recordingWriter struct {
+	Writer
+	names []string
+}
+
+func (r *recordingWriter) WriteFile(name string, data []byte) error {
+	err := r.Writer.WriteFile(name, data)
+	if err != nil {
+		return err
+	}
+
+	r.names = append(r.names, name)
+
+	return nil
+}